@@ -8,6 +8,7 @@ import (
 	"github.com/tendermint/starport/starport/pkg/cliquiz"
 	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
 	"github.com/tendermint/starport/starport/pkg/entrywriter"
+	"github.com/tendermint/starport/starport/pkg/xstrings"
 )
 
 const (
@@ -41,7 +42,11 @@ Starport uses accounts to interact with the Starport Network blockchain, use an
 func printAccounts(cmd *cobra.Command, accounts ...cosmosaccount.Account) error {
 	var accEntries [][]string
 	for _, acc := range accounts {
-		accEntries = append(accEntries, []string{acc.Name, acc.Address(getAddressPrefix(cmd)), acc.PubKey()})
+		accEntries = append(accEntries, []string{
+			acc.Name,
+			xstrings.TruncateMiddle(acc.Address(getAddressPrefix(cmd)), tableAddressMaxLen),
+			acc.PubKey(),
+		})
 	}
 	return entrywriter.MustWrite(os.Stdout, []string{"name", "address", "public key"}, accEntries...)
 }
@@ -84,7 +84,7 @@ func logLevel(cmd *cobra.Command) chain.LogLvl {
 func printEvents(wg *sync.WaitGroup, bus events.Bus, s *clispinner.Spinner) {
 	defer wg.Done()
 
-	for event := range bus {
+	for event := range bus.Events() {
 		if event.IsOngoing() {
 			s.SetText(event.Text())
 			s.Start()
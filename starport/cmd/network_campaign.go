@@ -0,0 +1,19 @@
+package starportcmd
+
+import "github.com/spf13/cobra"
+
+// NewNetworkCampaign creates a new campaign command that holds some other
+// sub commands related to the campaigns of chains.
+func NewNetworkCampaign() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "campaign",
+		Short: "Handle campaigns",
+	}
+
+	c.AddCommand(
+		NewNetworkCampaignList(),
+		NewNetworkCampaignShow(),
+	)
+
+	return c
+}
@@ -0,0 +1,99 @@
+package starportcmd
+
+import (
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/xstrings"
+	"github.com/tendermint/starport/starport/services/network/networktypes"
+)
+
+const flagCoordinator = "coordinator"
+
+// campaignSummary holds summarized information about a campaign
+type campaignSummary struct {
+	CampaignID         uint64 `json:"campaign_id"`
+	Name               string `json:"name"`
+	CoordinatorAddress string `json:"coordinator_address"`
+	TotalSupply        string `json:"total_supply"`
+	MainnetInitialized bool   `json:"mainnet_initialized"`
+}
+
+// NewNetworkCampaignList returns a new command to list the campaigns on Starport Network
+func NewNetworkCampaignList() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "list",
+		Short: "List campaigns",
+		Args:  cobra.NoArgs,
+		RunE:  networkCampaignListHandler,
+	}
+	c.Flags().String(flagCoordinator, "", "Only list the campaigns of the coordinator with this address")
+	c.Flags().AddFlagSet(flagNetworkFrom())
+	c.Flags().AddFlagSet(flagSetOutputFormat())
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetHome())
+
+	return c
+}
+
+func networkCampaignListHandler(cmd *cobra.Command, args []string) error {
+	coordinator, _ := cmd.Flags().GetString(flagCoordinator)
+	if coordinator != "" {
+		if err := xstrings.ValidateBech32(coordinator); err != nil {
+			return errors.Wrapf(err, "invalid coordinator address %s", coordinator)
+		}
+	}
+
+	outputFormat, err := getOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
+	if outputFormat == "" {
+		outputFormat = outputFormatTable
+	}
+
+	nb, err := newNetworkBuilder(cmd)
+	if err != nil {
+		return err
+	}
+	defer nb.Cleanup()
+
+	n, err := nb.Network()
+	if err != nil {
+		return err
+	}
+	campaigns, err := n.Campaigns(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	summaries := campaignSummaries(campaigns, coordinator)
+	if outputFormat == outputFormatTable {
+		for i := range summaries {
+			summaries[i].CoordinatorAddress = xstrings.TruncateMiddle(summaries[i].CoordinatorAddress, tableAddressMaxLen)
+		}
+	}
+
+	nb.Spinner.Stop()
+	return outputFormatter(os.Stdout, outputFormat, summaries)
+}
+
+// campaignSummaries returns the summaries of the campaigns, only the campaigns
+// of the coordinator are kept if its address is not empty
+func campaignSummaries(campaigns []networktypes.Campaign, coordinator string) []campaignSummary {
+	summaries := make([]campaignSummary, 0)
+	for _, c := range campaigns {
+		if coordinator != "" && c.CoordinatorAddress != coordinator {
+			continue
+		}
+		summaries = append(summaries, campaignSummary{
+			CampaignID:         c.ID,
+			Name:               c.Name,
+			CoordinatorAddress: c.CoordinatorAddress,
+			TotalSupply:        c.TotalSupply,
+			MainnetInitialized: c.MainnetInitialized,
+		})
+	}
+	return summaries
+}
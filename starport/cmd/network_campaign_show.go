@@ -0,0 +1,121 @@
+package starportcmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/entrywriter"
+	"github.com/tendermint/starport/starport/pkg/xstrings"
+	"github.com/tendermint/starport/starport/services/network/networktypes"
+)
+
+var (
+	campaignShareSummaryHeader = []string{"Account", "Shares"}
+	campaignChainSummaryHeader = []string{"Launch ID"}
+)
+
+// campaignDetails holds the campaign with its share allocations and chains
+type campaignDetails struct {
+	Campaign networktypes.Campaign        `json:"campaign"`
+	Shares   []networktypes.CampaignShare `json:"shares"`
+	Chains   []uint64                     `json:"chains"`
+}
+
+// NewNetworkCampaignShow returns a new command to show the details of a campaign on Starport Network
+func NewNetworkCampaignShow() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "show [campaign-id]",
+		Short: "Show the details of a campaign",
+		Args:  cobra.ExactArgs(1),
+		RunE:  networkCampaignShowHandler,
+	}
+	c.Flags().AddFlagSet(flagNetworkFrom())
+	c.Flags().AddFlagSet(flagSetOutputFormat())
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetHome())
+
+	return c
+}
+
+func networkCampaignShowHandler(cmd *cobra.Command, args []string) error {
+	campaignID, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "error parsing campaignID")
+	}
+
+	outputFormat, err := getOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
+
+	nb, err := newNetworkBuilder(cmd)
+	if err != nil {
+		return err
+	}
+	defer nb.Cleanup()
+
+	n, err := nb.Network()
+	if err != nil {
+		return err
+	}
+
+	campaign, err := n.Campaign(cmd.Context(), campaignID)
+	if err != nil {
+		return err
+	}
+	shares, err := n.CampaignShares(cmd.Context(), campaignID)
+	if err != nil {
+		return err
+	}
+	chains, err := n.CampaignChains(cmd.Context(), campaignID)
+	if err != nil {
+		return err
+	}
+
+	nb.Spinner.Stop()
+
+	details := campaignDetails{
+		Campaign: campaign,
+		Shares:   shares,
+		Chains:   chains,
+	}
+	if outputFormat == outputFormatJSON || outputFormat == outputFormatYAML {
+		return outputFormatter(os.Stdout, outputFormat, details)
+	}
+	return renderCampaignDetails(details, os.Stdout)
+}
+
+// renderCampaignDetails writes into the provided out, the campaign metadata, its share allocations and chains
+func renderCampaignDetails(details campaignDetails, out io.Writer) error {
+	if err := outputFormatter(out, outputFormatYAML, details.Campaign); err != nil {
+		return err
+	}
+	fmt.Fprintln(out)
+
+	if len(details.Shares) == 0 {
+		fmt.Fprintln(out, "No shares allocated for this campaign")
+	} else {
+		var shareEntries [][]string
+		for _, share := range details.Shares {
+			shareEntries = append(shareEntries, []string{xstrings.TruncateMiddle(share.Address, tableAddressMaxLen), share.Shares})
+		}
+		if err := entrywriter.MustWrite(out, campaignShareSummaryHeader, shareEntries...); err != nil {
+			return err
+		}
+	}
+	fmt.Fprintln(out)
+
+	if len(details.Chains) == 0 {
+		fmt.Fprintln(out, "No chains associated with this campaign")
+		return nil
+	}
+	var chainEntries [][]string
+	for _, launchID := range details.Chains {
+		chainEntries = append(chainEntries, []string{strconv.FormatUint(launchID, 10)})
+	}
+	return entrywriter.MustWrite(out, campaignChainSummaryHeader, chainEntries...)
+}
@@ -20,6 +20,7 @@ func NewNetworkChain() *cobra.Command {
 		NewNetworkChainPrepare(),
 		NewNetworkChainShow(),
 		NewNetworkChainLaunch(),
+		NewNetworkChainRevertLaunch(),
 	)
 
 	return c
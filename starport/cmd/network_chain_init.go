@@ -96,7 +96,13 @@ func networkChainInitHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	c, err := nb.Chain(networkchain.SourceLaunch(chainLaunch))
+	// the existing home directory is overwritten once confirmed
+	var initOptions []networkchain.Option
+	if exist {
+		initOptions = append(initOptions, networkchain.ForceReinitialize())
+	}
+
+	c, err := nb.Chain(networkchain.SourceLaunch(chainLaunch), initOptions...)
 	if err != nil {
 		return err
 	}
@@ -1,7 +1,11 @@
 package starportcmd
 
 import (
+	"os"
+	"time"
+
 	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/xtime"
 	"github.com/tendermint/starport/starport/services/network"
 )
 
@@ -9,6 +13,14 @@ const (
 	flagRemainingTime = "remaining-time"
 )
 
+// networkLaunchOutput is the result of the launch of a chain.
+type networkLaunchOutput struct {
+	LaunchID   uint64 `json:"launch_id"`
+	LaunchTime string `json:"launch_time"`
+	Status     string `json:"status"`
+	TxHash     string `json:"tx_hash"`
+}
+
 // NewNetworkChainLaunch creates a new chain launch command to launch
 // the network as a coordinator.
 func NewNetworkChainLaunch() *cobra.Command {
@@ -22,6 +34,7 @@ func NewNetworkChainLaunch() *cobra.Command {
 	c.Flags().Duration(flagRemainingTime, 0, "The remaining time for validator preparation before the chain is effectively launched")
 	c.Flags().AddFlagSet(flagNetworkFrom())
 	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetOutputFormat())
 
 	return c
 }
@@ -39,12 +52,42 @@ func networkChainLaunchHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	remainingTime, _ := cmd.Flags().GetDuration(flagRemainingTime)
+	// the launch time is left unset to use the minimum launch time of SPN
+	var launchTime time.Time
+	if remainingTime, _ := cmd.Flags().GetDuration(flagRemainingTime); remainingTime != 0 {
+		launchTime = time.Now().Add(remainingTime)
+	}
+
+	outputFormat, err := getOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
 
 	n, err := nb.Network()
 	if err != nil {
 		return err
 	}
 
-	return n.TriggerLaunch(cmd.Context(), launchID, remainingTime)
+	txHash, err := n.TriggerLaunchTx(cmd.Context(), launchID, launchTime)
+	if err != nil {
+		return err
+	}
+
+	if outputFormat == "" {
+		return nil
+	}
+
+	// the launch time set by SPN is fetched for the output
+	chainLaunch, err := n.ChainLaunch(cmd.Context(), launchID)
+	if err != nil {
+		return err
+	}
+
+	nb.Spinner.Stop()
+	return outputFormatter(os.Stdout, outputFormat, networkLaunchOutput{
+		LaunchID:   launchID,
+		LaunchTime: xtime.FormatUnix(time.Unix(chainLaunch.LaunchTime, 0)),
+		Status:     "launch triggered",
+		TxHash:     txHash,
+	})
 }
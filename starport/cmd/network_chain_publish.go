@@ -1,41 +1,64 @@
 package starportcmd
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
 	"os"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	"github.com/tendermint/starport/starport/pkg/clispinner"
+	"github.com/tendermint/starport/starport/pkg/httpstatuschecker"
 	"github.com/tendermint/starport/starport/services/network"
 	"github.com/tendermint/starport/starport/services/network/networkchain"
 )
 
 const (
-	flagTag      = "tag"
-	flagBranch   = "branch"
-	flagHash     = "hash"
-	flagGenesis  = "genesis"
-	flagCampaign = "campaign"
-	flagNoCheck  = "no-check"
-	flagChainID  = "chain-id"
+	flagTag              = "tag"
+	flagBranch           = "branch"
+	flagSourceURL        = "source-url"
+	flagSourceHash       = "source-hash"
+	flagGenesisURL       = "genesis-url"
+	flagGenesisHash      = "genesis-hash"
+	flagCampaignID       = "campaign-id"
+	flagNoCheck          = "no-check"
+	flagSkipGenesisCheck = "skip-genesis-check"
+	flagChainID          = "chain-id"
 )
 
+// publishFlagAliases maps the former flag names of the publish command to their current names.
+var publishFlagAliases = map[string]string{
+	"hash":     flagSourceHash,
+	"genesis":  flagGenesisURL,
+	"campaign": flagCampaignID,
+}
+
 // NewNetworkChainPublish returns a new command to publish a new chain to start a new network.
 func NewNetworkChainPublish() *cobra.Command {
 	c := &cobra.Command{
 		Use:   "publish [source-url]",
 		Short: "Publish a new chain to start a new network",
-		Args:  cobra.ExactArgs(1),
+		Args:  cobra.MaximumNArgs(1),
 		RunE:  networkChainPublishHandler,
 	}
 
+	c.Flags().String(flagSourceURL, "", "URL of the repo of the chain, can be provided as argument instead")
 	c.Flags().String(flagBranch, "", "Git branch to use for the repo")
 	c.Flags().String(flagTag, "", "Git tag to use for the repo")
-	c.Flags().String(flagHash, "", "Git hash to use for the repo")
-	c.Flags().String(flagGenesis, "", "URL to a custom Genesis")
+	c.Flags().String(flagSourceHash, "", "Git hash to use for the repo")
+	c.Flags().String(flagGenesisURL, "", "URL to a custom Genesis")
+	c.Flags().String(flagGenesisHash, "", "Expected hash of the custom Genesis")
 	c.Flags().String(flagChainID, "", "Chain ID to use for this network")
-	c.Flags().Uint64(flagCampaign, 0, "Campaign ID to use for this network")
+	c.Flags().Uint64(flagCampaignID, 0, "Campaign ID to use for this network")
 	c.Flags().Bool(flagNoCheck, false, "Skip verifying chain's integrity")
+	c.Flags().Bool(flagSkipGenesisCheck, false, "Skip verifying the custom Genesis URL is reachable")
+	c.Flags().SetNormalizeFunc(func(_ *pflag.FlagSet, name string) pflag.NormalizedName {
+		if alias, ok := publishFlagAliases[name]; ok {
+			name = alias
+		}
+		return pflag.NormalizedName(name)
+	})
 	c.Flags().AddFlagSet(flagNetworkFrom())
 	c.Flags().AddFlagSet(flagSetKeyringBackend())
 	c.Flags().AddFlagSet(flagSetHome())
@@ -46,16 +69,39 @@ func NewNetworkChainPublish() *cobra.Command {
 
 func networkChainPublishHandler(cmd *cobra.Command, args []string) error {
 	var (
-		source        = args[0]
-		tag, _        = cmd.Flags().GetString(flagTag)
-		branch, _     = cmd.Flags().GetString(flagBranch)
-		hash, _       = cmd.Flags().GetString(flagHash)
-		genesisURL, _ = cmd.Flags().GetString(flagGenesis)
-		chainID, _    = cmd.Flags().GetString(flagChainID)
-		campaign, _   = cmd.Flags().GetUint64(flagCampaign)
-		noCheck, _    = cmd.Flags().GetBool(flagNoCheck)
+		source, _           = cmd.Flags().GetString(flagSourceURL)
+		tag, _              = cmd.Flags().GetString(flagTag)
+		branch, _           = cmd.Flags().GetString(flagBranch)
+		hash, _             = cmd.Flags().GetString(flagSourceHash)
+		genesisURL, _       = cmd.Flags().GetString(flagGenesisURL)
+		genesisHash, _      = cmd.Flags().GetString(flagGenesisHash)
+		chainID, _          = cmd.Flags().GetString(flagChainID)
+		campaign, _         = cmd.Flags().GetUint64(flagCampaignID)
+		noCheck, _          = cmd.Flags().GetBool(flagNoCheck)
+		skipGenesisCheck, _ = cmd.Flags().GetBool(flagSkipGenesisCheck)
 	)
 
+	switch {
+	case len(args) > 0 && source != "":
+		return fmt.Errorf("source url must be provided either as argument or with --%s", flagSourceURL)
+	case len(args) > 0:
+		source = args[0]
+	case source == "":
+		return fmt.Errorf("source url is required, provide it as argument or with --%s", flagSourceURL)
+	}
+
+	// check the custom genesis is reachable before broadcasting anything.
+	if genesisURL != "" && !skipGenesisCheck {
+		isAvailable, err := httpstatuschecker.Check(cmd.Context(), genesisURL, httpstatuschecker.Method(http.MethodHead))
+		if err != nil {
+			return err
+		}
+		if !isAvailable {
+			fmt.Printf("%s Genesis URL %s is not reachable\n", clispinner.NotOK, genesisURL)
+			return errors.New("use --" + flagSkipGenesisCheck + " to publish the chain anyway")
+		}
+	}
+
 	nb, err := newNetworkBuilder(cmd)
 	if err != nil {
 		return err
@@ -82,6 +128,9 @@ func networkChainPublishHandler(cmd *cobra.Command, args []string) error {
 	if genesisURL != "" {
 		initOptions = append(initOptions, networkchain.WithGenesisFromURL(genesisURL))
 	}
+	if genesisHash != "" {
+		initOptions = append(initOptions, networkchain.WithGenesisHash(genesisHash))
+	}
 
 	// init in a temp dir.
 	homeDir, err := os.MkdirTemp("", "")
@@ -103,6 +152,9 @@ func networkChainPublishHandler(cmd *cobra.Command, args []string) error {
 	if genesisURL != "" {
 		publishOptions = append(publishOptions, network.WithCustomGenesis(genesisURL))
 	}
+	if genesisHash != "" {
+		publishOptions = append(publishOptions, network.WithCustomGenesisHash(genesisHash))
+	}
 
 	if campaign != 0 {
 		publishOptions = append(publishOptions, network.WithCampaign(campaign))
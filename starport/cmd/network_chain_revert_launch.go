@@ -0,0 +1,90 @@
+package starportcmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	"github.com/tendermint/starport/starport/services/network"
+	"github.com/tendermint/starport/starport/services/network/networkchain"
+)
+
+const (
+	flagDryRun = "dry-run"
+)
+
+// NewNetworkChainRevertLaunch creates a new chain revert launch command
+// to revert a launched chain as a coordinator.
+func NewNetworkChainRevertLaunch() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "revert-launch [launch-id]",
+		Short: "Revert launch a network as a coordinator",
+		Args:  cobra.ExactArgs(1),
+		RunE:  networkChainRevertLaunchHandler,
+	}
+
+	c.Flags().Bool(flagDryRun, false, "Check the launch can be reverted without broadcasting the transaction")
+	c.Flags().AddFlagSet(flagNetworkFrom())
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().AddFlagSet(flagSetOutputFormat())
+
+	return c
+}
+
+func networkChainRevertLaunchHandler(cmd *cobra.Command, args []string) error {
+	nb, err := newNetworkBuilder(cmd)
+	if err != nil {
+		return err
+	}
+	defer nb.Cleanup()
+
+	// parse launch ID
+	launchID, err := network.ParseLaunchID(args[0])
+	if err != nil {
+		return err
+	}
+
+	outputFormat, err := getOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
+
+	n, err := nb.Network()
+	if err != nil {
+		return err
+	}
+
+	chainLaunch, err := n.ChainLaunch(cmd.Context(), launchID)
+	if err != nil {
+		return err
+	}
+
+	c, err := nb.Chain(networkchain.SourceLaunch(chainLaunch))
+	if err != nil {
+		return err
+	}
+
+	if dryRun, _ := cmd.Flags().GetBool(flagDryRun); dryRun {
+		return n.SimulateRevertLaunch(cmd.Context(), launchID, c)
+	}
+
+	txHash, err := n.RevertLaunch(launchID, c)
+	if err != nil {
+		return err
+	}
+
+	nb.Spinner.Stop()
+
+	if outputFormat != "" {
+		return outputFormatter(os.Stdout, outputFormat, networkTxOutput{
+			LaunchID: launchID,
+			TxHash:   txHash,
+			Status:   "launch reverted",
+		})
+	}
+
+	fmt.Printf("%s Chain %d launch reverted\n", clispinner.OK, launchID)
+	return nil
+}
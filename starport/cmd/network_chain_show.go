@@ -12,6 +12,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/tendermint/starport/starport/pkg/cosmosutil"
 	"github.com/tendermint/starport/starport/pkg/entrywriter"
+	"github.com/tendermint/starport/starport/pkg/xstrings"
 	"github.com/tendermint/starport/starport/pkg/yaml"
 	"github.com/tendermint/starport/starport/services/network"
 	"github.com/tendermint/starport/starport/services/network/networkchain"
@@ -192,15 +193,25 @@ func newNetworkChainShowAccounts() *cobra.Command {
 
 			accountSummary := bytes.NewBufferString("")
 
-			// get all chain genesis accounts
+			// get all chain genesis accounts including the vesting accounts
 			genesisAccs, err := n.GenesisAccounts(cmd.Context(), launchID)
 			if err != nil {
 				return err
 			}
 			genesisAccEntries := make([][]string, 0)
+			genesisVestingAccEntries := make([][]string, 0)
 			for _, acc := range genesisAccs {
+				if acc.VestingSchedule != nil {
+					genesisVestingAccEntries = append(genesisVestingAccEntries, []string{
+						xstrings.TruncateMiddle(acc.Address, tableAddressMaxLen),
+						acc.Coins,
+						acc.VestingSchedule.Vesting,
+						strconv.FormatInt(acc.VestingSchedule.EndTime, 10),
+					})
+					continue
+				}
 				genesisAccEntries = append(genesisAccEntries, []string{
-					acc.Address,
+					xstrings.TruncateMiddle(acc.Address, tableAddressMaxLen),
 					acc.Coins,
 				})
 			}
@@ -213,21 +224,6 @@ func newNetworkChainShowAccounts() *cobra.Command {
 					return err
 				}
 			}
-
-			// get all chain vesting accounts
-			vestingAccs, err := n.VestingAccounts(cmd.Context(), launchID)
-			if err != nil {
-				return err
-			}
-			genesisVestingAccEntries := make([][]string, 0)
-			for _, acc := range vestingAccs {
-				genesisVestingAccEntries = append(genesisVestingAccEntries, []string{
-					acc.Address,
-					acc.TotalBalance,
-					acc.Vesting,
-					strconv.FormatInt(acc.EndTime, 10),
-				})
-			}
 			if len(genesisVestingAccEntries) > 0 {
 				if err = entrywriter.MustWrite(
 					accountSummary,
@@ -269,7 +265,7 @@ func newNetworkChainShowValidators() *cobra.Command {
 			validatorEntries := make([][]string, 0)
 			for _, acc := range validators {
 				validatorEntries = append(validatorEntries, []string{
-					acc.Address,
+					xstrings.TruncateMiddle(acc.Address, tableAddressMaxLen),
 					acc.SelfDelegation.String(),
 					acc.Peer,
 				})
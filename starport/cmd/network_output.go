@@ -0,0 +1,141 @@
+package starportcmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
+	"github.com/tendermint/starport/starport/pkg/entrywriter"
+)
+
+const (
+	flagOutputFormat = "output-format"
+
+	outputFormatJSON  = "json"
+	outputFormatYAML  = "yaml"
+	outputFormatTable = "table"
+
+	// tableAddressMaxLen is the maximum length of the addresses displayed in tables
+	tableAddressMaxLen = 20
+)
+
+// networkTxOutput is the result of a network command broadcasting a transaction to SPN.
+type networkTxOutput struct {
+	LaunchID   uint64   `json:"launch_id"`
+	RequestIDs []uint64 `json:"request_ids,omitempty"`
+	TxHash     string   `json:"tx_hash"`
+	Status     string   `json:"status"`
+}
+
+func flagSetOutputFormat() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(flagOutputFormat, "", "Output format of the command result (json|yaml|table)")
+	return fs
+}
+
+// getOutputFormat returns the output format selected with the output format flag,
+// an empty format means the human-readable output of the command.
+func getOutputFormat(cmd *cobra.Command) (string, error) {
+	format, _ := cmd.Flags().GetString(flagOutputFormat)
+	switch format {
+	case "", outputFormatJSON, outputFormatYAML, outputFormatTable:
+		return format, nil
+	default:
+		return "", fmt.Errorf("unknown output format %q, must be one of json, yaml or table", format)
+	}
+}
+
+// outputFormatter writes v into out in the given format, v must be a struct or a slice
+// of structs to be rendered as a table, its fields are used as table columns.
+func outputFormatter(out io.Writer, format string, v interface{}) error {
+	switch format {
+	case outputFormatJSON:
+		data, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(out, string(data))
+		return err
+	case outputFormatYAML:
+		data, err := yaml.Marshal(v)
+		if err != nil {
+			return err
+		}
+		_, err = out.Write(data)
+		return err
+	case outputFormatTable:
+		header, entries, err := tableEntries(v)
+		if err != nil {
+			return err
+		}
+		return entrywriter.Write(out, header, entries...)
+	default:
+		return fmt.Errorf("unknown output format %q", format)
+	}
+}
+
+// tableEntries returns the table header and entries for a struct or a slice of structs.
+func tableEntries(v interface{}) (header []string, entries [][]string, err error) {
+	value := reflect.Indirect(reflect.ValueOf(v))
+	if !value.IsValid() {
+		return nil, nil, errors.New("nil value cannot be rendered as a table")
+	}
+
+	var rows []reflect.Value
+	switch value.Kind() {
+	case reflect.Struct:
+		rows = append(rows, value)
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			row := reflect.Indirect(value.Index(i))
+			if !row.IsValid() {
+				return nil, nil, fmt.Errorf("nil element %d cannot be rendered as a table", i)
+			}
+			rows = append(rows, row)
+		}
+	}
+
+	elemType := value.Type()
+	if value.Kind() == reflect.Slice || value.Kind() == reflect.Array {
+		elemType = elemType.Elem()
+		if elemType.Kind() == reflect.Ptr {
+			elemType = elemType.Elem()
+		}
+	}
+	if elemType.Kind() != reflect.Struct {
+		return nil, nil, fmt.Errorf("%s cannot be rendered as a table", value.Type())
+	}
+
+	var fields []int
+	for i := 0; i < elemType.NumField(); i++ {
+		field := elemType.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		name := field.Name
+		if tag := strings.Split(field.Tag.Get("json"), ",")[0]; tag != "" && tag != "-" {
+			name = strings.ReplaceAll(tag, "_", " ")
+		}
+		header = append(header, name)
+		fields = append(fields, i)
+	}
+	if len(header) == 0 {
+		return nil, nil, fmt.Errorf("%s has no exported fields to render as a table", elemType)
+	}
+
+	for _, row := range rows {
+		entry := make([]string, 0, len(fields))
+		for _, i := range fields {
+			entry = append(entry, fmt.Sprint(row.Field(i).Interface()))
+		}
+		entries = append(entries, entry)
+	}
+
+	return header, entries, nil
+}
@@ -0,0 +1,116 @@
+package starportcmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testOutput struct {
+	LaunchID uint64 `json:"launch_id"`
+	Name     string `json:"name,omitempty"`
+	Hidden   string `json:"-"`
+	Count    int
+
+	unexported string
+}
+
+func TestOutputFormatter(t *testing.T) {
+	output := testOutput{LaunchID: 1, Name: "foo", Hidden: "bar", Count: 2, unexported: "baz"}
+
+	tests := []struct {
+		name   string
+		format string
+		v      interface{}
+		want   string
+		err    string
+	}{
+		{
+			name:   "json",
+			format: outputFormatJSON,
+			v:      output,
+			want: `{
+  "launch_id": 1,
+  "name": "foo",
+  "Count": 2
+}
+`,
+		},
+		{
+			name:   "yaml",
+			format: outputFormatYAML,
+			v:      output,
+			want: `Count: 2
+launch_id: 1
+name: foo
+`,
+		},
+		{
+			name:   "table struct",
+			format: outputFormatTable,
+			v:      output,
+			want:   "Launch Id \tName \tHidden \tCount \t\n1 \t\tfoo \tbar \t2 \t\n\n",
+		},
+		{
+			name:   "table struct pointer",
+			format: outputFormatTable,
+			v:      &output,
+			want:   "Launch Id \tName \tHidden \tCount \t\n1 \t\tfoo \tbar \t2 \t\n\n",
+		},
+		{
+			name:   "table slice of pointers",
+			format: outputFormatTable,
+			v:      []*testOutput{&output, {LaunchID: 2}},
+			want:   "Launch Id \tName \tHidden \tCount \t\n1 \t\tfoo \tbar \t2 \t\n2 \t\t \t \t0 \t\n\n",
+		},
+		{
+			name:   "table empty slice",
+			format: outputFormatTable,
+			v:      []testOutput{},
+			want:   "Launch Id \tName \tHidden \tCount \t\n\n",
+		},
+		{
+			name:   "table nil pointer",
+			format: outputFormatTable,
+			v:      (*testOutput)(nil),
+			err:    "nil value cannot be rendered as a table",
+		},
+		{
+			name:   "table nil element",
+			format: outputFormatTable,
+			v:      []*testOutput{&output, nil},
+			err:    "nil element 1 cannot be rendered as a table",
+		},
+		{
+			name:   "table no exported fields",
+			format: outputFormatTable,
+			v:      struct{ foo string }{foo: "bar"},
+			err:    "struct { foo string } has no exported fields to render as a table",
+		},
+		{
+			name:   "table not a struct",
+			format: outputFormatTable,
+			v:      []string{"foo"},
+			err:    "[]string cannot be rendered as a table",
+		},
+		{
+			name:   "unknown format",
+			format: "xml",
+			v:      output,
+			err:    `unknown output format "xml"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			err := outputFormatter(&out, tt.format, tt.v)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, out.String())
+		})
+	}
+}
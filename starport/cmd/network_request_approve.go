@@ -2,6 +2,7 @@ package starportcmd
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/spf13/cobra"
 	"github.com/tendermint/starport/starport/pkg/clispinner"
@@ -27,6 +28,7 @@ func NewNetworkRequestApprove() *cobra.Command {
 	c.Flags().AddFlagSet(flagNetworkFrom())
 	c.Flags().AddFlagSet(flagSetHome())
 	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetOutputFormat())
 	return c
 }
 
@@ -56,6 +58,11 @@ func networkRequestApproveHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	outputFormat, err := getOutputFormat(cmd)
+	if err != nil {
+		return err
+	}
+
 	n, err := nb.Network()
 	if err != nil {
 		return err
@@ -72,11 +79,22 @@ func networkRequestApproveHandler(cmd *cobra.Command, args []string) error {
 	for _, id := range ids {
 		reviewals = append(reviewals, network.ApproveRequest(id))
 	}
-	if err := n.SubmitRequest(launchID, reviewals...); err != nil {
+	txHash, err := n.SubmitRequest(launchID, reviewals...)
+	if err != nil {
 		return err
 	}
 
 	nb.Spinner.Stop()
+
+	if outputFormat != "" {
+		return outputFormatter(os.Stdout, outputFormat, networkTxOutput{
+			LaunchID:   launchID,
+			RequestIDs: ids,
+			TxHash:     txHash,
+			Status:     "approved",
+		})
+	}
+
 	fmt.Printf("%s Request(s) %s approved\n", clispinner.OK, numbers.List(ids, "#"))
 	return nil
 }
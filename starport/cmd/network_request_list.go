@@ -8,6 +8,7 @@ import (
 	"github.com/spf13/cobra"
 	launchtypes "github.com/tendermint/spn/x/launch/types"
 	"github.com/tendermint/starport/starport/pkg/entrywriter"
+	"github.com/tendermint/starport/starport/pkg/xstrings"
 	"github.com/tendermint/starport/starport/services/network"
 )
 
@@ -68,13 +69,13 @@ func renderRequestSummaries(requests []launchtypes.Request, out io.Writer) error
 		case *launchtypes.RequestContent_GenesisAccount:
 			requestType = "Add Genesis Account"
 			content = fmt.Sprintf("%s, %s",
-				req.GenesisAccount.Address,
+				xstrings.TruncateMiddle(req.GenesisAccount.Address, tableAddressMaxLen),
 				req.GenesisAccount.Coins.String())
 		case *launchtypes.RequestContent_GenesisValidator:
 			requestType = "Add Genesis Validator"
 			content = fmt.Sprintf("%s, %s, %s",
 				req.GenesisValidator.Peer,
-				req.GenesisValidator.Address,
+				xstrings.TruncateMiddle(req.GenesisValidator.Address, tableAddressMaxLen),
 				req.GenesisValidator.SelfDelegation.String())
 		case *launchtypes.RequestContent_VestingAccount:
 			requestType = "Add Vesting Account"
@@ -88,15 +89,15 @@ func renderRequestSummaries(requests []launchtypes.Request, out io.Writer) error
 				vestingCoins = fmt.Sprintf("%s (vesting: %s)", dv.TotalBalance, dv.Vesting)
 			}
 			content = fmt.Sprintf("%s, %s",
-				req.VestingAccount.Address,
+				xstrings.TruncateMiddle(req.VestingAccount.Address, tableAddressMaxLen),
 				vestingCoins,
 			)
 		case *launchtypes.RequestContent_ValidatorRemoval:
 			requestType = "Remove Validator"
-			content = req.ValidatorRemoval.ValAddress
+			content = xstrings.TruncateMiddle(req.ValidatorRemoval.ValAddress, tableAddressMaxLen)
 		case *launchtypes.RequestContent_AccountRemoval:
 			requestType = "Remove Account"
-			content = req.AccountRemoval.Address
+			content = xstrings.TruncateMiddle(req.AccountRemoval.Address, tableAddressMaxLen)
 		}
 
 		requestEntries = append(requestEntries, []string{
@@ -55,7 +55,7 @@ func networkRequestRejectHandler(cmd *cobra.Command, args []string) error {
 	for _, id := range ids {
 		reviewals = append(reviewals, network.RejectRequest(id))
 	}
-	if err := n.SubmitRequest(launchID, reviewals...); err != nil {
+	if _, err := n.SubmitRequest(launchID, reviewals...); err != nil {
 		return err
 	}
 
@@ -0,0 +1,116 @@
+package sperrors
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ErrLaunchNotFound is returned when a chain launch doesn't exist on SPN.
+type ErrLaunchNotFound struct {
+	LaunchID uint64
+}
+
+func (e ErrLaunchNotFound) Error() string {
+	return fmt.Sprintf("launch %d not found", e.LaunchID)
+}
+
+// ErrLaunchAlreadyTriggered is returned when an operation requires the launch of a chain to not be triggered.
+type ErrLaunchAlreadyTriggered struct {
+	LaunchID uint64
+}
+
+func (e ErrLaunchAlreadyTriggered) Error() string {
+	return fmt.Sprintf("the launch of chain %d is already triggered", e.LaunchID)
+}
+
+// ErrInsufficientValidators is returned when a chain launch has less genesis validators than required.
+type ErrInsufficientValidators struct {
+	LaunchID   uint64
+	Validators int
+	Required   int
+}
+
+func (e ErrInsufficientValidators) Error() string {
+	return fmt.Sprintf("insufficient genesis validators: chain %d has %d genesis validators, %d required",
+		e.LaunchID,
+		e.Validators,
+		e.Required,
+	)
+}
+
+// ErrNotCoordinator is returned when an address is not the coordinator of a chain launch.
+type ErrNotCoordinator struct {
+	Address  string
+	LaunchID uint64
+}
+
+func (e ErrNotCoordinator) Error() string {
+	return fmt.Sprintf("%s is not the coordinator of launch %d", e.Address, e.LaunchID)
+}
+
+// ErrNoCampaignLinked is returned when a chain launch is not linked to a campaign.
+type ErrNoCampaignLinked struct {
+	LaunchID uint64
+}
+
+func (e ErrNoCampaignLinked) Error() string {
+	return fmt.Sprintf("no campaign linked to launch %d", e.LaunchID)
+}
+
+// ErrAlreadyCoordinator is returned when an address registering as a coordinator is already a coordinator.
+type ErrAlreadyCoordinator struct {
+	Address string
+}
+
+func (e ErrAlreadyCoordinator) Error() string {
+	return fmt.Sprintf("%s is already a coordinator", e.Address)
+}
+
+// ErrCoordinatorNotFound is returned when an address is not registered as a coordinator on SPN.
+type ErrCoordinatorNotFound struct {
+	Address string
+}
+
+func (e ErrCoordinatorNotFound) Error() string {
+	return fmt.Sprintf("%s is not a registered coordinator", e.Address)
+}
+
+// ErrGenesisHashMismatch is returned when the genesis fetched from a URL doesn't have the expected hash.
+type ErrGenesisHashMismatch struct {
+	GenesisURL string
+	Expected   string
+	Actual     string
+}
+
+func (e ErrGenesisHashMismatch) Error() string {
+	return fmt.Sprintf("genesis from URL %s is invalid. Expected hash %s, actual hash %s", e.GenesisURL, e.Expected, e.Actual)
+}
+
+// ErrBinaryNotFound is returned when the binary of a chain can't be found.
+type ErrBinaryNotFound struct {
+	Binary        string
+	SearchedPaths []string
+}
+
+func (e ErrBinaryNotFound) Error() string {
+	return fmt.Sprintf("binary %s not found, searched in: %s", e.Binary, strings.Join(e.SearchedPaths, ", "))
+}
+
+// ErrRequestNotPending is returned when a launch request is expected to be pending.
+type ErrRequestNotPending struct {
+	LaunchID  uint64
+	RequestID uint64
+}
+
+func (e ErrRequestNotPending) Error() string {
+	return fmt.Sprintf("request %d of launch %d is not pending", e.RequestID, e.LaunchID)
+}
+
+// ErrInvalidGentx is returned when a gentx is malformed or misses required fields.
+type ErrInvalidGentx struct {
+	Reason string
+}
+
+func (e ErrInvalidGentx) Error() string {
+	return fmt.Sprintf("invalid gentx: %s", e.Reason)
+}
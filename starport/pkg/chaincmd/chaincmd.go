@@ -2,6 +2,7 @@ package chaincmd
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
 	"github.com/tendermint/starport/starport/pkg/cosmosver"
@@ -21,6 +22,7 @@ const (
 	commandQuery             = "query"
 	commandUnsafeReset       = "unsafe-reset-all"
 	commandExport            = "export"
+	commandMigrate           = "migrate"
 
 	optionHome                             = "--home"
 	optionNode                             = "--node"
@@ -46,6 +48,7 @@ const (
 	optionVestingAmount                    = "--vesting-amount"
 	optionVestingEndTime                   = "--vesting-end-time"
 	optionBroadcastMode                    = "--broadcast-mode"
+	optionHeight                           = "--height"
 
 	constTendermint = "tendermint"
 	constJSON       = "json"
@@ -493,11 +496,42 @@ func (c ChainCmd) UnsafeResetCommand() step.Option {
 	return c.daemonCommand(command)
 }
 
+// ExportOption for the ExportCommand
+type ExportOption func([]string) []string
+
+// ExportWithHeight provides the height option for the export command
+// the state is exported at the latest height when height is 0
+func ExportWithHeight(height int64) ExportOption {
+	return func(command []string) []string {
+		if height > 0 {
+			return append(command, optionHeight, strconv.FormatInt(height, 10))
+		}
+		return command
+	}
+}
+
 // ExportCommand returns the command to export the state of the blockchain into a genesis file
-func (c ChainCmd) ExportCommand() step.Option {
+func (c ChainCmd) ExportCommand(options ...ExportOption) step.Option {
 	command := []string{
 		commandExport,
 	}
+
+	// Apply the options provided by the user
+	for _, applyOption := range options {
+		command = applyOption(command)
+	}
+
+	return c.daemonCommand(command)
+}
+
+// MigrateCommand returns the command to migrate the provided genesis file to the SDK version of the blockchain
+func (c ChainCmd) MigrateCommand(genesisPath string) step.Option {
+	command := []string{
+		commandMigrate,
+		genesisPath,
+	}
+
+	command = c.attachChainID(command)
 	return c.daemonCommand(command)
 }
 
@@ -235,21 +235,41 @@ func (r Runner) WaitTx(ctx context.Context, txHash string, retryDelay time.Durat
 
 // Export exports the state of the chain into the specified file
 func (r Runner) Export(ctx context.Context, exportedFile string) error {
-	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
-	if err := r.run(ctx, runOptions{stdout: stdout, stderr: stderr}, r.chainCmd.ExportCommand()); err != nil {
+	exportedState, err := r.ExportState(ctx)
+	if err != nil {
 		return err
 	}
 
+	// Save the new state
+	return os.WriteFile(exportedFile, exportedState, 0644)
+}
+
+// ExportState exports the state of the chain and returns it as a genesis
+func (r Runner) ExportState(ctx context.Context, options ...chaincmd.ExportOption) ([]byte, error) {
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	if err := r.run(ctx, runOptions{stdout: stdout, stderr: stderr}, r.chainCmd.ExportCommand(options...)); err != nil {
+		return nil, err
+	}
+
 	// Exported genesis is written on stderr from Cosmos-SDK v0.44.0
-	var exportedState []byte
 	if stdout.Len() > 0 {
-		exportedState = stdout.Bytes()
-	} else {
-		exportedState = stderr.Bytes()
+		return stdout.Bytes(), nil
 	}
+	return stderr.Bytes(), nil
+}
 
-	// Save the new state
-	return os.WriteFile(exportedFile, exportedState, 0644)
+// Migrate migrates the provided genesis file and returns the migrated genesis
+func (r Runner) Migrate(ctx context.Context, genesisPath string) ([]byte, error) {
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	if err := r.run(ctx, runOptions{stdout: stdout, stderr: stderr}, r.chainCmd.MigrateCommand(genesisPath)); err != nil {
+		return nil, err
+	}
+
+	// Migrated genesis might be written on stderr like for the export command
+	if stdout.Len() > 0 {
+		return stdout.Bytes(), nil
+	}
+	return stderr.Bytes(), nil
 }
 
 // EventSelector is used to query events.
@@ -5,10 +5,16 @@ import (
 	"crypto/sha256"
 	"fmt"
 	"io"
+	"io/fs"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"strings"
 )
 
+// gitDir is the directory of the git metadata of a source
+const gitDir = ".git"
+
 // Sum reads files from dirPath, calculates sha256 for each file and creates a new checksum
 // file for them in outPath.
 func Sum(dirPath, outPath string) error {
@@ -39,3 +45,94 @@ func Sum(dirPath, outPath string) error {
 
 	return os.WriteFile(outPath, b.Bytes(), 0666)
 }
+
+// DirectoryChecksum calculates a deterministic sha256 checksum of the regular files under dirPath.
+// Files are walked in lexical order and each file path relative to dirPath is hashed along with
+// the checksum of its content. Git metadata is skipped so a source checksum doesn't depend on it.
+func DirectoryChecksum(dirPath string) (string, error) {
+	h := sha256.New()
+
+	err := filepath.WalkDir(dirPath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if d.Name() == gitDir && path != dirPath {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dirPath, path)
+		if err != nil {
+			return err
+		}
+		sum, err := fileChecksum(path)
+		if err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintf(h, "%x %s\n", sum, filepath.ToSlash(rel))
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
+}
+
+// fileChecksum calculates the sha256 checksum of the file content.
+func fileChecksum(path string) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return nil, err
+	}
+	return h.Sum(nil), nil
+}
+
+// ChecksumMismatch is returned when the checksum of a binary is not the expected one
+type ChecksumMismatch struct {
+	Got  string
+	Want string
+}
+
+// Error implements error
+func (e ChecksumMismatch) Error() string {
+	return fmt.Sprintf("checksum mismatch: got %s, want %s", e.Got, e.Want)
+}
+
+// BinaryChecksum calculates the sha256 checksum of the binary found in the PATH with binaryName.
+func BinaryChecksum(binaryName string) (string, error) {
+	binaryPath, err := exec.LookPath(binaryName)
+	if err != nil {
+		return "", err
+	}
+	sum, err := fileChecksum(binaryPath)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%x", sum), nil
+}
+
+// VerifyBinary checks the sha256 checksum of the binary found in the PATH with binaryName
+// is expectedChecksum, a ChecksumMismatch error is returned when the checksums differ.
+func VerifyBinary(binaryName, expectedChecksum string) error {
+	got, err := BinaryChecksum(binaryName)
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(got, expectedChecksum) {
+		return ChecksumMismatch{Got: got, Want: expectedChecksum}
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+package checksum
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// writeFiles writes the files by path relative to dir in the given order.
+func writeFiles(t *testing.T, dir string, files [][2]string) {
+	for _, f := range files {
+		path := filepath.Join(dir, f[0])
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(f[1]), 0644))
+	}
+}
+
+func sha256Hex(s string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(s)))
+}
+
+func TestSum(t *testing.T) {
+	dir := t.TempDir()
+	writeFiles(t, dir, [][2]string{{"b", "bar"}, {"a", "foo"}})
+
+	out := filepath.Join(t.TempDir(), "checksum.txt")
+	require.NoError(t, Sum(dir, out))
+
+	content, err := os.ReadFile(out)
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("%s a\n%s b\n", sha256Hex("foo"), sha256Hex("bar")), string(content))
+}
+
+func TestDirectoryChecksum(t *testing.T) {
+	files := [][2]string{
+		{"a.txt", "foo"},
+		{"sub/b.txt", "bar"},
+		{"c.txt", "baz"},
+	}
+
+	dir := t.TempDir()
+	writeFiles(t, dir, files)
+
+	checksum, err := DirectoryChecksum(dir)
+	require.NoError(t, err)
+
+	// the checksum is computed from the files walked in lexical order.
+	want := sha256Hex(fmt.Sprintf(
+		"%s a.txt\n%s c.txt\n%s sub/b.txt\n",
+		sha256Hex("foo"),
+		sha256Hex("baz"),
+		sha256Hex("bar"),
+	))
+	require.Equal(t, want, checksum)
+
+	t.Run("stable", func(t *testing.T) {
+		again, err := DirectoryChecksum(dir)
+		require.NoError(t, err)
+		require.Equal(t, checksum, again)
+	})
+
+	t.Run("creation order", func(t *testing.T) {
+		dir := t.TempDir()
+		writeFiles(t, dir, [][2]string{files[2], files[1], files[0]})
+
+		reordered, err := DirectoryChecksum(dir)
+		require.NoError(t, err)
+		require.Equal(t, checksum, reordered)
+	})
+
+	t.Run("git dir ignored", func(t *testing.T) {
+		dir := t.TempDir()
+		writeFiles(t, dir, append(files, [2]string{".git/HEAD", "ref: refs/heads/main"}))
+
+		withGit, err := DirectoryChecksum(dir)
+		require.NoError(t, err)
+		require.Equal(t, checksum, withGit)
+	})
+
+	t.Run("content changed", func(t *testing.T) {
+		dir := t.TempDir()
+		writeFiles(t, dir, files)
+		writeFiles(t, dir, [][2]string{{"sub/b.txt", "changed"}})
+
+		changed, err := DirectoryChecksum(dir)
+		require.NoError(t, err)
+		require.NotEqual(t, checksum, changed)
+	})
+
+	t.Run("file renamed", func(t *testing.T) {
+		dir := t.TempDir()
+		writeFiles(t, dir, files)
+		require.NoError(t, os.Rename(filepath.Join(dir, "a.txt"), filepath.Join(dir, "d.txt")))
+
+		renamed, err := DirectoryChecksum(dir)
+		require.NoError(t, err)
+		require.NotEqual(t, checksum, renamed)
+	})
+
+	t.Run("missing dir", func(t *testing.T) {
+		_, err := DirectoryChecksum(filepath.Join(dir, "missing"))
+		require.Error(t, err)
+	})
+}
+
+// setTestPath replaces the PATH with a dir containing an executable binary with content.
+func setTestPath(t *testing.T, binaryName, content string) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, binaryName), []byte(content), 0755))
+
+	path := os.Getenv("PATH")
+	require.NoError(t, os.Setenv("PATH", dir))
+	t.Cleanup(func() { os.Setenv("PATH", path) })
+}
+
+func TestBinaryChecksum(t *testing.T) {
+	setTestPath(t, "foo", "#!/bin/sh\n")
+
+	checksum, err := BinaryChecksum("foo")
+	require.NoError(t, err)
+	require.Equal(t, sha256Hex("#!/bin/sh\n"), checksum)
+
+	_, err = BinaryChecksum("bar")
+	require.Error(t, err)
+}
+
+func TestVerifyBinary(t *testing.T) {
+	content := "#!/bin/sh\n"
+	setTestPath(t, "foo", content)
+
+	tests := []struct {
+		name     string
+		binary   string
+		expected string
+		err      error
+	}{
+		{
+			name:     "match",
+			binary:   "foo",
+			expected: sha256Hex(content),
+		},
+		{
+			name:     "match case insensitive",
+			binary:   "foo",
+			expected: strings.ToUpper(sha256Hex(content)),
+		},
+		{
+			name:     "mismatch",
+			binary:   "foo",
+			expected: sha256Hex("bar"),
+			err:      ChecksumMismatch{Got: sha256Hex(content), Want: sha256Hex("bar")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := VerifyBinary(tt.binary, tt.expected)
+			if tt.err != nil {
+				require.Equal(t, tt.err, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("binary not found", func(t *testing.T) {
+		err := VerifyBinary("bar", sha256Hex(content))
+		require.Error(t, err)
+		require.False(t, errors.As(err, &ChecksumMismatch{}))
+	})
+}
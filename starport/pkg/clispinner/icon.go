@@ -5,5 +5,6 @@ import "github.com/fatih/color"
 var (
 	// OK is an OK mark.
 	OK     = color.New(color.FgGreen).SprintFunc()("✔")
+	NotOK  = color.New(color.FgRed).SprintFunc()("✘")
 	Bullet = color.New(color.FgYellow).SprintFunc()("⋆")
 )
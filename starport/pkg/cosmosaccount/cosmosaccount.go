@@ -44,6 +44,10 @@ const (
 	// KeyringOS is the OS keyring backend. with this backend, your keys will be
 	// stored in your operating system's secured keyring.
 	KeyringOS KeyringBackend = "os"
+
+	// KeyringMemory is the in-memory keyring backend. With this backend, your keys are
+	// not persisted and are lost once the registry is released.
+	KeyringMemory KeyringBackend = "memory"
 )
 
 // Registry for accounts.
@@ -114,6 +118,9 @@ type Account struct {
 
 	// Info holds additional info about the account.
 	Info keyring.Info
+
+	// Mnemonic of the account, only set when the account is created outside of the registry.
+	Mnemonic string
 }
 
 // Address returns the address of the account from given prefix.
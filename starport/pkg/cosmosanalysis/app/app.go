@@ -10,16 +10,10 @@ import (
 	"github.com/tendermint/starport/starport/pkg/cosmosanalysis"
 )
 
-var appImplementation = []string{
-	"RegisterAPIRoutes",
-	"RegisterTxService",
-	"RegisterTendermintService",
-}
-
 // CheckKeeper checks for the existence of the keeper with the provided name in the app structure
 func CheckKeeper(path, keeperName string) error {
 	// find app type
-	appImpl, err := cosmosanalysis.FindImplementation(path, appImplementation)
+	appImpl, err := cosmosanalysis.FindImplementation(path, cosmosanalysis.AppImplementation)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,198 @@
+package cosmosanalysis
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	consensusVersionFuncName        = "ConsensusVersion"
+	registerUpgradeHandlersFuncName = "RegisterUpgradeHandlers"
+)
+
+// moduleVersionExcludedDirs is the list of directories skipped while validating the module versions
+var moduleVersionExcludedDirs = []string{"vendor", "node_modules", "testdata"}
+
+// appModuleImplementation is the list of methods needed for a module.AppModule implementation
+var appModuleImplementation = []string{
+	"Name",
+	"RegisterInterfaces",
+	"DefaultGenesis",
+	"ValidateGenesis",
+	"RegisterRESTRoutes",
+	"RegisterGRPCGatewayRoutes",
+	"GetTxCmd",
+	"GetQueryCmd",
+	"RegisterInvariants",
+	"Route",
+	"QuerierRoute",
+	"LegacyQuerierHandler",
+	"RegisterServices",
+	"ConsensusVersion",
+}
+
+// FindModuleInterfaces finds the name of all types under the chain root that implement
+// the Cosmos SDK module.AppModule interface.
+func FindModuleInterfaces(chainRoot string) ([]string, error) {
+	return DeepFindImplementation(chainRoot, appModuleImplementation)
+}
+
+// ValidateModuleVersion checks that the chain registers upgrade handlers when one of its modules
+// has a consensus version greater than one, the upgrade handlers are registered by a RegisterUpgradeHandlers call.
+// It returns the names of the modules whose version was bumped without registered upgrade handlers.
+// Vendor, node_modules, testdata and hidden directories are skipped as well as the directories that can't be parsed.
+func ValidateModuleVersion(chainRoot string) (invalid []string, err error) {
+	var hasUpgradeHandlers bool
+
+	err = filepath.Walk(chainRoot, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			return nil
+		}
+		if path != chainRoot && isModuleVersionExcludedDir(info.Name()) {
+			return filepath.SkipDir
+		}
+
+		fset := token.NewFileSet()
+		pkgs, err := parser.ParseDir(fset, path, isSourceFile, 0)
+		if err != nil {
+			// directories that don't contain valid Go files can't declare modules
+			return nil
+		}
+
+		for _, pkg := range pkgs {
+			var files []*ast.File
+			for _, f := range pkg.Files {
+				files = append(files, f)
+			}
+
+			if hasCall(files, registerUpgradeHandlersFuncName) {
+				hasUpgradeHandlers = true
+			}
+
+			for _, structName := range findImplementationInFiles(files, appModuleImplementation, AnyReceiver) {
+				version, pos, ok := findConsensusVersion(files, structName)
+				if !ok || version <= 1 {
+					continue
+				}
+				rel, err := filepath.Rel(chainRoot, fset.Position(pos).Filename)
+				if err != nil {
+					return err
+				}
+				invalid = append(invalid, moduleNameFromPath(rel, pkg.Name))
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if hasUpgradeHandlers {
+		return nil, nil
+	}
+
+	sort.Strings(invalid)
+	return invalid, nil
+}
+
+// isModuleVersionExcludedDir checks if the directory is skipped while validating the module versions.
+func isModuleVersionExcludedDir(name string) bool {
+	if strings.HasPrefix(name, ".") {
+		return true
+	}
+	for _, dir := range moduleVersionExcludedDirs {
+		if name == dir {
+			return true
+		}
+	}
+	return false
+}
+
+// isSourceFile checks if the file is a non-test Go file.
+func isSourceFile(info os.FileInfo) bool {
+	return !strings.HasSuffix(info.Name(), "_test.go")
+}
+
+// findConsensusVersion returns the value returned by the ConsensusVersion method of the struct
+// and the position of the method. Only integer literals and constants declared in the files are resolved.
+func findConsensusVersion(files []*ast.File, structName string) (version uint64, pos token.Pos, found bool) {
+	for _, f := range files {
+		for _, decl := range f.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || funcDecl.Recv == nil || funcDecl.Name.Name != consensusVersionFuncName || funcDecl.Body == nil {
+				continue
+			}
+			if name, _, ok := receiverTypeName(funcDecl.Recv.List[0].Type); !ok || name != structName {
+				continue
+			}
+
+			for _, stmt := range funcDecl.Body.List {
+				returnStmt, ok := stmt.(*ast.ReturnStmt)
+				if !ok || len(returnStmt.Results) != 1 {
+					continue
+				}
+				version, found = resolveUintValue(files, returnStmt.Results[0])
+				return version, funcDecl.Pos(), found
+			}
+		}
+	}
+
+	return 0, token.NoPos, false
+}
+
+// hasCall checks if the files contain a call to a function or a method named funcName.
+func hasCall(files []*ast.File, funcName string) (found bool) {
+	for _, f := range files {
+		ast.Inspect(f, func(n ast.Node) bool {
+			callExpr, ok := n.(*ast.CallExpr)
+			if !ok {
+				return !found
+			}
+			switch fun := callExpr.Fun.(type) {
+			case *ast.SelectorExpr:
+				found = found || fun.Sel.Name == funcName
+			case *ast.Ident:
+				found = found || fun.Name == funcName
+			}
+			return !found
+		})
+	}
+	return found
+}
+
+// resolveUintValue resolves the integer value of an expression that is either
+// an integer literal or a constant declared in the files.
+func resolveUintValue(files []*ast.File, expr ast.Expr) (uint64, bool) {
+	switch e := expr.(type) {
+	case *ast.BasicLit:
+		if e.Kind != token.INT {
+			return 0, false
+		}
+		v, err := strconv.ParseUint(e.Value, 0, 64)
+		return v, err == nil
+	case *ast.Ident:
+		for _, f := range files {
+			if obj := f.Scope.Lookup(e.Name); obj != nil && obj.Kind == ast.Con {
+				spec, ok := obj.Decl.(*ast.ValueSpec)
+				if !ok {
+					continue
+				}
+				for i, name := range spec.Names {
+					if name.Name == e.Name && i < len(spec.Values) {
+						return resolveUintValue(files, spec.Values[i])
+					}
+				}
+			}
+		}
+	}
+	return 0, false
+}
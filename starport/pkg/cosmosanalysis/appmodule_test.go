@@ -0,0 +1,150 @@
+package cosmosanalysis_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/pkg/cosmosanalysis"
+)
+
+var appModuleFile = []byte(`
+package foo
+
+type AppModule struct {}
+func (AppModule) Name() string { return "foo" }
+func (AppModule) RegisterInterfaces() {}
+func (AppModule) DefaultGenesis() {}
+func (AppModule) ValidateGenesis() {}
+func (AppModule) RegisterRESTRoutes() {}
+func (AppModule) RegisterGRPCGatewayRoutes() {}
+func (AppModule) GetTxCmd() {}
+func (AppModule) GetQueryCmd() {}
+func (AppModule) RegisterInvariants() {}
+func (AppModule) Route() {}
+func (AppModule) QuerierRoute() {}
+func (AppModule) LegacyQuerierHandler() {}
+func (AppModule) RegisterServices() {}
+func (AppModule) ConsensusVersion() uint64 { return 2 }
+
+type AppModuleBasic struct {}
+func (AppModuleBasic) Name() string { return "foo" }
+func (AppModuleBasic) RegisterInterfaces() {}
+func (AppModuleBasic) DefaultGenesis() {}
+func (AppModuleBasic) ValidateGenesis() {}
+`)
+
+func TestFindModuleInterfaces(t *testing.T) {
+	tmpDir := t.TempDir()
+	moduleDir := filepath.Join(tmpDir, "x", "foo")
+	require.NoError(t, os.MkdirAll(moduleDir, 0755))
+
+	err := os.WriteFile(filepath.Join(moduleDir, "module.go"), appModuleFile, 0644)
+	require.NoError(t, err)
+
+	found, err := cosmosanalysis.FindModuleInterfaces(tmpDir)
+	require.NoError(t, err)
+	require.Equal(t, []string{"AppModule"}, found)
+}
+
+// writeTestFile writes content to the file at path, creating its directory.
+func writeTestFile(t *testing.T, path string, content []byte) {
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	require.NoError(t, os.WriteFile(path, content, 0644))
+}
+
+func TestValidateModuleVersion(t *testing.T) {
+	// bar module is at version 1, it doesn't need upgrade handlers
+	barModuleFile := []byte(`
+package bar
+
+const consensusVersion = 1
+
+type AppModule struct {}
+func (AppModule) Name() string { return "bar" }
+func (AppModule) RegisterInterfaces() {}
+func (AppModule) DefaultGenesis() {}
+func (AppModule) ValidateGenesis() {}
+func (AppModule) RegisterRESTRoutes() {}
+func (AppModule) RegisterGRPCGatewayRoutes() {}
+func (AppModule) GetTxCmd() {}
+func (AppModule) GetQueryCmd() {}
+func (AppModule) RegisterInvariants() {}
+func (AppModule) Route() {}
+func (AppModule) QuerierRoute() {}
+func (AppModule) LegacyQuerierHandler() {}
+func (AppModule) RegisterServices() {}
+func (AppModule) ConsensusVersion() uint64 { return consensusVersion }
+`)
+	appFile := []byte(`
+package app
+
+func New() *App {
+	app := &App{}
+	app.RegisterUpgradeHandlers()
+	return app
+}
+`)
+
+	tests := []struct {
+		name    string
+		files   map[string][]byte
+		invalid []string
+	}{
+		{
+			name: "no upgrade handlers",
+			files: map[string][]byte{
+				"x/foo/module_foo.go": appModuleFile,
+				"x/bar/module.go":     barModuleFile,
+			},
+			invalid: []string{"foo"},
+		},
+		{
+			name: "upgrade handlers registered",
+			files: map[string][]byte{
+				"app/app.go":          appFile,
+				"x/foo/module_foo.go": appModuleFile,
+				"x/bar/module.go":     barModuleFile,
+			},
+		},
+		{
+			name: "upgrade handlers registered in a test file",
+			files: map[string][]byte{
+				"app/app_test.go":     appFile,
+				"x/foo/module_foo.go": appModuleFile,
+			},
+			invalid: []string{"foo"},
+		},
+		{
+			name: "skipped directories",
+			files: map[string][]byte{
+				"vendor/x/foo/module.go":       appModuleFile,
+				"node_modules/x/foo/module.go": appModuleFile,
+				"testdata/x/foo/module.go":     appModuleFile,
+				".cache/x/foo/module.go":       appModuleFile,
+				"x/bar/module.go":              barModuleFile,
+			},
+		},
+		{
+			name: "invalid go files",
+			files: map[string][]byte{
+				"scripts/main.go": []byte("package main\n\nfunc main() {"),
+				"x/foo/module.go": appModuleFile,
+			},
+			invalid: []string{"foo"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			for name, content := range tt.files {
+				writeTestFile(t, filepath.Join(tmpDir, name), content)
+			}
+
+			invalid, err := cosmosanalysis.ValidateModuleVersion(tmpDir)
+			require.NoError(t, err)
+			require.Equal(t, tt.invalid, invalid)
+		})
+	}
+}
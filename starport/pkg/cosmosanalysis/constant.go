@@ -0,0 +1,268 @@
+package cosmosanalysis
+
+import (
+	"errors"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/tendermint/starport/starport/pkg/goenv"
+	"github.com/tendermint/starport/starport/pkg/gomodule"
+	"golang.org/x/mod/module"
+)
+
+// maxConstDepth is the maximum number of constants followed to resolve the value of a constant.
+const maxConstDepth = 16
+
+// majorVersionRe matches the major version suffix of the import paths of Go modules.
+var majorVersionRe = regexp.MustCompile(`^v[0-9]+$`)
+
+// sourceFile is a Go file of a package.
+type sourceFile struct {
+	// dir is the directory of the package of the file.
+	dir string
+
+	file *ast.File
+}
+
+// stringConst is a constant declared at the top level of a package.
+type stringConst struct {
+	value ast.Expr
+
+	// file declares the constant, its imports are used to resolve the value.
+	file sourceFile
+}
+
+// constResolver resolves the values of the string constants used by the Go files of a chain.
+// constants are looked up in the package declaring them, which is either a package of the chain
+// or a package of one of its dependencies found in the Go module cache.
+type constResolver struct {
+	chainRoot  string
+	modulePath string
+
+	// modules are the dirs of the chain dependencies by module path.
+	modules map[string]string
+
+	// packages are the constants of the packages by package dir.
+	packages map[string]map[string]stringConst
+}
+
+// newConstResolver creates a resolver for the constants of the chain, files are the already
+// parsed Go files of the chain.
+func newConstResolver(chainRoot string, files []sourceFile) (*constResolver, error) {
+	r := &constResolver{
+		chainRoot: chainRoot,
+		modules:   make(map[string]string),
+		packages:  make(map[string]map[string]stringConst),
+	}
+
+	modFile, err := gomodule.ParseAt(chainRoot)
+	switch {
+	case errors.Is(err, gomodule.ErrGoModNotFound):
+		// constants can only be resolved inside the packages of a chain without go.mod.
+	case err != nil:
+		return nil, err
+	default:
+		r.modulePath = modFile.Module.Mod.Path
+
+		for _, req := range modFile.Require {
+			dep := req.Mod
+			for _, rep := range modFile.Replace {
+				if rep.Old.Path == dep.Path && (rep.Old.Version == "" || rep.Old.Version == dep.Version) {
+					dep = rep.New
+				}
+			}
+			dir, err := moduleDir(chainRoot, dep)
+			if err != nil {
+				return nil, err
+			}
+			r.modules[req.Mod.Path] = dir
+		}
+	}
+
+	for _, f := range files {
+		r.addFile(f)
+	}
+
+	return r, nil
+}
+
+// moduleDir returns the dir of the source code of a module, the module is either replaced
+// by a local dir or downloaded into the Go module cache.
+func moduleDir(chainRoot string, m module.Version) (string, error) {
+	if m.Version == "" {
+		if filepath.IsAbs(m.Path) {
+			return m.Path, nil
+		}
+		return filepath.Join(chainRoot, m.Path), nil
+	}
+
+	escapedPath, err := module.EscapePath(m.Path)
+	if err != nil {
+		return "", err
+	}
+	escapedVersion, err := module.EscapeVersion(m.Version)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(goenv.ModCache(), escapedPath+"@"+escapedVersion), nil
+}
+
+// addFile adds the constants declared in the file to the constants of its package.
+func (r *constResolver) addFile(f sourceFile) {
+	consts, ok := r.packages[f.dir]
+	if !ok {
+		consts = make(map[string]stringConst)
+		r.packages[f.dir] = consts
+	}
+
+	for _, decl := range f.file.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			valueSpec := spec.(*ast.ValueSpec)
+			for i, name := range valueSpec.Names {
+				if i < len(valueSpec.Values) {
+					consts[name.Name] = stringConst{value: valueSpec.Values[i], file: f}
+				}
+			}
+		}
+	}
+}
+
+// packageConsts returns the constants of the package in dir, the package is parsed the first
+// time its constants are requested.
+func (r *constResolver) packageConsts(dir string) map[string]stringConst {
+	if consts, ok := r.packages[dir]; ok {
+		return consts
+	}
+	r.packages[dir] = make(map[string]stringConst)
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		// the package is not available, e.g. the dependency is not downloaded
+		return r.packages[dir]
+	}
+
+	fset := token.NewFileSet()
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || filepath.Ext(name) != ".go" || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, 0)
+		if err != nil {
+			continue
+		}
+		r.addFile(sourceFile{dir: dir, file: f})
+	}
+
+	return r.packages[dir]
+}
+
+// packageDir returns the dir of the package imported with the import path.
+func (r *constResolver) packageDir(importPath string) (string, bool) {
+	if r.modulePath != "" && (importPath == r.modulePath || strings.HasPrefix(importPath, r.modulePath+"/")) {
+		return filepath.Join(r.chainRoot, strings.TrimPrefix(importPath, r.modulePath)), true
+	}
+
+	// the dependency with the longest module path is the one providing the package
+	var modulePath string
+	for path := range r.modules {
+		if (importPath == path || strings.HasPrefix(importPath, path+"/")) && len(path) > len(modulePath) {
+			modulePath = path
+		}
+	}
+	if modulePath == "" {
+		return "", false
+	}
+	return filepath.Join(r.modules[modulePath], strings.TrimPrefix(importPath, modulePath)), true
+}
+
+// resolve returns the value of a string expression of the file. the expression can be a string
+// literal, a constant of the package of the file or of an imported package, or a concatenation of those.
+func (r *constResolver) resolve(expr ast.Expr, f sourceFile) (string, bool) {
+	return r.resolveDepth(expr, f, 0)
+}
+
+func (r *constResolver) resolveDepth(expr ast.Expr, f sourceFile, depth int) (string, bool) {
+	if depth > maxConstDepth {
+		return "", false
+	}
+
+	switch e := expr.(type) {
+	case *ast.ParenExpr:
+		return r.resolveDepth(e.X, f, depth)
+	case *ast.BinaryExpr:
+		if e.Op != token.ADD {
+			return "", false
+		}
+		x, ok := r.resolveDepth(e.X, f, depth)
+		if !ok {
+			return "", false
+		}
+		y, ok := r.resolveDepth(e.Y, f, depth)
+		return x + y, ok
+	case *ast.Ident:
+		c, ok := r.packageConsts(f.dir)[e.Name]
+		if !ok {
+			return "", false
+		}
+		return r.resolveDepth(c.value, c.file, depth+1)
+	case *ast.SelectorExpr:
+		pkg, ok := e.X.(*ast.Ident)
+		if !ok {
+			return "", false
+		}
+		importPath, ok := importPathByName(f.file, pkg.Name)
+		if !ok {
+			return "", false
+		}
+		dir, ok := r.packageDir(importPath)
+		if !ok {
+			return "", false
+		}
+		c, ok := r.packageConsts(dir)[e.Sel.Name]
+		if !ok {
+			return "", false
+		}
+		return r.resolveDepth(c.value, c.file, depth+1)
+	}
+	return stringLitValue(expr)
+}
+
+// importPathByName returns the path of the package imported by the file under name.
+func importPathByName(f *ast.File, name string) (string, bool) {
+	for _, imp := range f.Imports {
+		importPath, err := strconv.Unquote(imp.Path.Value)
+		if err != nil {
+			continue
+		}
+		importName := defaultPackageName(importPath)
+		if imp.Name != nil {
+			importName = imp.Name.Name
+		}
+		if importName == name {
+			return importPath, true
+		}
+	}
+	return "", false
+}
+
+// defaultPackageName returns the name a package is imported under by default, which is
+// the last element of its import path without the major version suffix.
+func defaultPackageName(importPath string) string {
+	name := path.Base(importPath)
+	if majorVersionRe.MatchString(name) {
+		name = path.Base(path.Dir(importPath))
+	}
+	return name
+}
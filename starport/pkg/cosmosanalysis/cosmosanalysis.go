@@ -3,17 +3,51 @@
 package cosmosanalysis
 
 import (
+	"errors"
 	"fmt"
 	"go/ast"
 	"go/parser"
 	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
 
 	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/semver"
 )
 
 const (
 	cosmosModulePath     = "github.com/cosmos/cosmos-sdk"
 	tendermintModulePath = "github.com/tendermint/tendermint"
+	appFileName          = "app.go"
+	defaultAppFilePath   = "app/" + appFileName
+)
+
+// AppImplementation is the list of methods needed for a Cosmos SDK app implementation
+var AppImplementation = []string{
+	"RegisterAPIRoutes",
+	"RegisterTxService",
+	"RegisterTendermintService",
+}
+
+// defaultExcludedDirs is the list of directories skipped by default while looking for implementations
+var defaultExcludedDirs = []string{"vendor", "testdata"}
+
+// ReceiverKind defines which kind of method receivers are taken into account
+// while looking for interface implementations.
+type ReceiverKind int
+
+const (
+	// AnyReceiver accepts methods defined either on value or pointer receivers.
+	AnyReceiver ReceiverKind = iota
+
+	// ValueReceiver only accepts methods defined on value receivers.
+	ValueReceiver
+
+	// PointerReceiver only accepts methods defined on pointer receivers.
+	PointerReceiver
 )
 
 // implementation tracks the implementation of an interface for a given struct
@@ -21,53 +55,81 @@ type implementation map[string]bool
 
 // FindImplementation finds the name of all types that implement the provided interface
 func FindImplementation(modulePath string, interfaceList []string) (found []string, err error) {
+	return FindImplementationWithReceiver(modulePath, interfaceList, AnyReceiver)
+}
+
+// FindImplementationWithReceiver finds the name of all types that implement the provided interface
+// with methods defined on the given kind of receiver.
+func FindImplementationWithReceiver(modulePath string, interfaceList []string, kind ReceiverKind) (found []string, err error) {
+	return findImplementation(modulePath, interfaceList, kind, nil)
+}
+
+// findImplementation finds the name of all types that implement the provided interface in the
+// Go files under the module path that pass the filter, all files are parsed when filter is nil.
+func findImplementation(
+	modulePath string,
+	interfaceList []string,
+	kind ReceiverKind,
+	filter func(fs.FileInfo) bool,
+) (found []string, err error) {
 	// parse go packages/files under path
 	fset := token.NewFileSet()
 
-	// collect all structs under path to find out the ones that satisfies the implementation
-	structImplementations := make(map[string]implementation)
-	pkgs, err := parser.ParseDir(fset, modulePath, nil, 0)
+	pkgs, err := parser.ParseDir(fset, modulePath, filter, 0)
 	if err != nil {
 		return nil, err
 	}
+
+	var files []*ast.File
 	for _, pkg := range pkgs {
 		for _, f := range pkg.Files {
-			ast.Inspect(f, func(n ast.Node) bool {
-				// look for struct methods.
-				methodDecl, ok := n.(*ast.FuncDecl)
-				if !ok {
-					return true
-				}
+			files = append(files, f)
+		}
+	}
 
-				// not a method.
-				if methodDecl.Recv == nil {
-					return true
-				}
+	return findImplementationInFiles(files, interfaceList, kind), nil
+}
 
-				methodName := methodDecl.Name.Name
-
-				// find the struct name that method belongs to.
-				t := methodDecl.Recv.List[0].Type
-				ident, ok := t.(*ast.Ident)
-				if !ok {
-					sexp, ok := t.(*ast.StarExpr)
-					if !ok {
-						return true
-					}
-					ident = sexp.X.(*ast.Ident)
-				}
-				structName := ident.Name
+// findImplementationInFiles finds the name of all types that implement the provided interface
+// in the given files.
+func findImplementationInFiles(files []*ast.File, interfaceList []string, kind ReceiverKind) (found []string) {
+	// collect all structs under path to find out the ones that satisfies the implementation
+	structImplementations := make(map[string]implementation)
+	for _, f := range files {
+		ast.Inspect(f, func(n ast.Node) bool {
+			// look for struct methods.
+			methodDecl, ok := n.(*ast.FuncDecl)
+			if !ok {
+				return true
+			}
 
-				// mark the implementation that this struct satisfies.
-				if _, ok := structImplementations[structName]; !ok {
-					structImplementations[structName] = newImplementation(interfaceList)
-				}
+			// not a method.
+			if methodDecl.Recv == nil {
+				return true
+			}
 
-				structImplementations[structName][methodName] = true
+			methodName := methodDecl.Name.Name
 
+			// find the struct name that method belongs to.
+			structName, isPointer, ok := receiverTypeName(methodDecl.Recv.List[0].Type)
+			if !ok {
 				return true
-			})
-		}
+			}
+
+			// skip methods not defined on the requested receiver kind.
+			if (kind == ValueReceiver && isPointer) || (kind == PointerReceiver && !isPointer) {
+				return true
+			}
+
+			// mark the implementation that this struct satisfies.
+			if _, ok := structImplementations[structName]; !ok {
+				structImplementations[structName] = newImplementation(interfaceList)
+			}
+
+			structImplementations[structName][methodName] = true
+
+			return true
+		})
 	}
 
 	// append structs that satisfy the implementation
@@ -77,9 +139,178 @@ func FindImplementation(modulePath string, interfaceList []string) (found []stri
 		}
 	}
 
+	return found
+}
+
+// DeepFindImplementation finds the name of all types that implement the provided interface
+// in the module path and all its subdirectories except vendor and testdata ones.
+func DeepFindImplementation(modulePath string, interfaceList []string) (found []string, err error) {
+	return DeepFindImplementationExclude(modulePath, interfaceList, defaultExcludedDirs)
+}
+
+// DeepFindImplementationExclude finds the name of all types that implement the provided interface
+// in the module path and all its subdirectories, skipping the directories named as one of excludeDirs.
+func DeepFindImplementationExclude(modulePath string, interfaceList []string, excludeDirs []string) (found []string, err error) {
+	err = filepath.Walk(modulePath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			return nil
+		}
+		for _, dir := range excludeDirs {
+			if path != modulePath && info.Name() == dir {
+				return filepath.SkipDir
+			}
+		}
+
+		currFound, err := FindImplementation(path, interfaceList)
+		if err != nil {
+			return err
+		}
+		found = append(found, currFound...)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return found, nil
 }
 
+// FindAppFilePath looks for the app file that implements the interfaces listed in AppImplementation
+func FindAppFilePath(chainRoot string) (path string, err error) {
+	return FindAppFilePathByInterface(chainRoot, AppImplementation)
+}
+
+// FindAppFilePathByInterface looks for the app file that implements the methods listed in interfaceList,
+// it allows finding the app of chains whose app struct doesn't implement the standard methods
+func FindAppFilePathByInterface(chainRoot string, interfaceList []string) (path string, err error) {
+	var found []string
+
+	err = filepath.Walk(chainRoot, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || filepath.Ext(path) != ".go" {
+			return nil
+		}
+
+		fset := token.NewFileSet()
+		f, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return err
+		}
+		if len(findImplementationInFiles([]*ast.File{f}, interfaceList, AnyReceiver)) > 0 {
+			found = append(found, path)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	switch len(found) {
+	case 0:
+		return "", errors.New("app.go file cannot be found")
+	case 1:
+		return found[0], nil
+	}
+
+	// when there are multiple candidates prefer the one named app.go
+	var appFilePath string
+	for _, p := range found {
+		if filepath.Base(p) != appFileName {
+			continue
+		}
+		if appFilePath != "" {
+			// multiple app.go files found, fallback to the default location
+			return defaultAppFile(chainRoot)
+		}
+		appFilePath = p
+	}
+	if appFilePath != "" {
+		return appFilePath, nil
+	}
+
+	return defaultAppFile(chainRoot)
+}
+
+// defaultAppFile returns the app file path at its default location when it exists.
+func defaultAppFile(chainRoot string) (string, error) {
+	path := filepath.Join(chainRoot, defaultAppFilePath)
+	if _, err := os.Stat(path); err != nil {
+		return "", errors.New("cannot locate your app.go")
+	}
+	return path, nil
+}
+
+// receiverTypeName returns the type name of a method receiver and whether it is a pointer receiver.
+func receiverTypeName(t ast.Expr) (name string, isPointer bool, ok bool) {
+	if sexp, isStar := t.(*ast.StarExpr); isStar {
+		t = sexp.X
+		isPointer = true
+	}
+	ident, ok := t.(*ast.Ident)
+	if !ok {
+		return "", false, false
+	}
+	return ident.Name, isPointer, true
+}
+
+// FindInterfaceDefinitions finds all the interfaces defined in the Go files under the
+// provided module path and returns their method names indexed by interface name.
+func FindInterfaceDefinitions(modulePath string) (map[string][]string, error) {
+	fset := token.NewFileSet()
+	definitions := make(map[string][]string)
+
+	err := filepath.Walk(modulePath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || filepath.Ext(path) != ".go" {
+			return nil
+		}
+
+		f, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return err
+		}
+
+		ast.Inspect(f, func(n ast.Node) bool {
+			// look for interface type declarations.
+			typeSpec, ok := n.(*ast.TypeSpec)
+			if !ok {
+				return true
+			}
+			interfaceType, ok := typeSpec.Type.(*ast.InterfaceType)
+			if !ok {
+				return true
+			}
+
+			methods := []string{}
+			for _, method := range interfaceType.Methods.List {
+				// embedded interfaces don't have names.
+				for _, name := range method.Names {
+					methods = append(methods, name.Name)
+				}
+			}
+			definitions[typeSpec.Name.Name] = methods
+
+			return false
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return definitions, nil
+}
+
 // newImplementation returns a new object to parse implementation of an interface
 func newImplementation(interfaceList []string) implementation {
 	impl := make(implementation)
@@ -101,15 +332,53 @@ func checkImplementation(r implementation) bool {
 
 // ValidateGoMod check if the cosmos-sdk and the tendermint packages are imported.
 func ValidateGoMod(module *modfile.File) error {
-	moduleCheck := map[string]bool{
-		cosmosModulePath:     true,
-		tendermintModulePath: true,
-	}
+	return ValidateGoModVersions(module, map[string]string{
+		cosmosModulePath:     "",
+		tendermintModulePath: "",
+	})
+}
+
+// ValidateGoModVersions checks if the required packages are imported with at least the
+// minimum version. requirements maps the module paths to their minimum semver version,
+// an empty version only checks that the module is imported.
+func ValidateGoModVersions(module *modfile.File, requirements map[string]string) error {
+	versions := make(map[string]string)
 	for _, r := range module.Require {
-		delete(moduleCheck, r.Mod.Path)
+		versions[r.Mod.Path] = r.Mod.Version
+	}
+
+	var (
+		paths    []string
+		outdated []string
+	)
+	for path := range requirements {
+		paths = append(paths, path)
 	}
-	for m := range moduleCheck {
-		return fmt.Errorf("invalid go module, missing %s package dependency", m)
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		version, ok := versions[path]
+		if !ok {
+			return fmt.Errorf("invalid go module, missing %s package dependency", path)
+		}
+
+		minVersion := requirements[path]
+		if minVersion == "" {
+			continue
+		}
+		if !strings.HasPrefix(minVersion, "v") {
+			minVersion = "v" + minVersion
+		}
+		if !semver.IsValid(minVersion) {
+			return fmt.Errorf("invalid minimum version %s for %s package", requirements[path], path)
+		}
+		if semver.Compare(version, minVersion) < 0 {
+			outdated = append(outdated, fmt.Sprintf("%s %s (minimum %s)", path, version, minVersion))
+		}
+	}
+
+	if len(outdated) > 0 {
+		return fmt.Errorf("invalid go module, outdated package dependencies: %s", strings.Join(outdated, ", "))
 	}
 	return nil
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/starport/starport/pkg/cosmosanalysis"
+	"golang.org/x/mod/modfile"
 )
 
 var (
@@ -68,3 +69,221 @@ func TestFindImplementation(t *testing.T) {
 	_, err = cosmosanalysis.FindImplementation(filepath.Join(tmpDir, "1.go"), expectedinterface)
 	require.Error(t, err)
 }
+
+func TestFindImplementationWithReceiver(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	file := []byte(`
+package foo
+
+type Foo struct {}
+func (f Foo) foo() {}
+func (f Foo) bar() {}
+func (f Foo) foobar() {}
+
+type Bar struct {}
+func (b *Bar) foo() {}
+func (b *Bar) bar() {}
+func (b *Bar) foobar() {}
+
+type Foobar struct {}
+func (f Foobar) foo() {}
+func (f *Foobar) bar() {}
+func (f Foobar) foobar() {}
+`)
+	err := os.WriteFile(filepath.Join(tmpDir, "1.go"), file, 0644)
+	require.NoError(t, err)
+
+	found, err := cosmosanalysis.FindImplementationWithReceiver(tmpDir, expectedinterface, cosmosanalysis.AnyReceiver)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"Foo", "Bar", "Foobar"}, found)
+
+	found, err = cosmosanalysis.FindImplementationWithReceiver(tmpDir, expectedinterface, cosmosanalysis.ValueReceiver)
+	require.NoError(t, err)
+	require.Equal(t, []string{"Foo"}, found)
+
+	found, err = cosmosanalysis.FindImplementationWithReceiver(tmpDir, expectedinterface, cosmosanalysis.PointerReceiver)
+	require.NoError(t, err)
+	require.Equal(t, []string{"Bar"}, found)
+}
+
+func TestFindInterfaceDefinitions(t *testing.T) {
+	tmpDir := t.TempDir()
+	subDir := filepath.Join(tmpDir, "sub")
+	require.NoError(t, os.Mkdir(subDir, 0755))
+
+	file1 := []byte(`
+package foo
+
+type Foo interface {
+	foo()
+	bar() error
+}
+
+type Bar struct {}
+`)
+	file2 := []byte(`
+package sub
+
+type Foobar interface {
+	Foo
+	foobar(string) int
+}
+`)
+	err := os.WriteFile(filepath.Join(tmpDir, "1.go"), file1, 0644)
+	require.NoError(t, err)
+	err = os.WriteFile(filepath.Join(subDir, "2.go"), file2, 0644)
+	require.NoError(t, err)
+	err = os.WriteFile(filepath.Join(tmpDir, "README.md"), []byte("# foo"), 0644)
+	require.NoError(t, err)
+
+	definitions, err := cosmosanalysis.FindInterfaceDefinitions(tmpDir)
+	require.NoError(t, err)
+	require.Equal(t, map[string][]string{
+		"Foo":    {"foo", "bar"},
+		"Foobar": {"foobar"},
+	}, definitions)
+
+	// invalid path
+	_, err = cosmosanalysis.FindInterfaceDefinitions(filepath.Join(tmpDir, "invalid"))
+	require.Error(t, err)
+}
+
+func TestDeepFindImplementation(t *testing.T) {
+	tmpDir := t.TempDir()
+	subDir := filepath.Join(tmpDir, "sub")
+	require.NoError(t, os.Mkdir(subDir, 0755))
+
+	err := os.WriteFile(filepath.Join(tmpDir, "1.go"), file1, 0644)
+	require.NoError(t, err)
+	err = os.WriteFile(filepath.Join(subDir, "2.go"), file2, 0644)
+	require.NoError(t, err)
+
+	// testdata directories are skipped by default
+	testdataDir := filepath.Join(tmpDir, "testdata")
+	require.NoError(t, os.Mkdir(testdataDir, 0755))
+	err = os.WriteFile(filepath.Join(testdataDir, "3.go"), []byte(`
+package testdata
+
+type Barfoo struct {}
+func (b Barfoo) foo() {}
+func (b Barfoo) bar() {}
+func (b Barfoo) foobar() {}
+`), 0644)
+	require.NoError(t, err)
+
+	found, err := cosmosanalysis.DeepFindImplementation(tmpDir, expectedinterface)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"Foo", "Foobar"}, found)
+
+	found, err = cosmosanalysis.DeepFindImplementationExclude(tmpDir, expectedinterface, nil)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"Foo", "Foobar", "Barfoo"}, found)
+
+	found, err = cosmosanalysis.DeepFindImplementationExclude(tmpDir, expectedinterface, []string{"sub"})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"Foo", "Barfoo"}, found)
+
+	// invalid path
+	_, err = cosmosanalysis.DeepFindImplementation(filepath.Join(tmpDir, "invalid"), expectedinterface)
+	require.Error(t, err)
+}
+
+var appFile = []byte(`
+package app
+
+type App struct {}
+func (app *App) RegisterAPIRoutes() {}
+func (app *App) RegisterTxService() {}
+func (app *App) RegisterTendermintService() {}
+`)
+
+func TestFindAppFilePath(t *testing.T) {
+	tmpDir := t.TempDir()
+	appDir := filepath.Join(tmpDir, "app")
+	require.NoError(t, os.Mkdir(appDir, 0755))
+
+	// no app file
+	_, err := cosmosanalysis.FindAppFilePath(tmpDir)
+	require.Error(t, err)
+
+	// app file with custom name
+	err = os.WriteFile(filepath.Join(appDir, "chain.go"), appFile, 0644)
+	require.NoError(t, err)
+	path, err := cosmosanalysis.FindAppFilePath(tmpDir)
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(appDir, "chain.go"), path)
+
+	// app.go is preferred when there are multiple candidates
+	err = os.WriteFile(filepath.Join(appDir, "app.go"), appFile, 0644)
+	require.NoError(t, err)
+	path, err = cosmosanalysis.FindAppFilePath(tmpDir)
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(appDir, "app.go"), path)
+}
+
+func TestFindAppFilePathByInterface(t *testing.T) {
+	tmpDir := t.TempDir()
+	appDir := filepath.Join(tmpDir, "app")
+	require.NoError(t, os.Mkdir(appDir, 0755))
+
+	customAppFile := []byte(`
+package app
+
+type App struct {}
+func (app *App) Name() string { return "" }
+func (app *App) EndBlocker() {}
+`)
+	err := os.WriteFile(filepath.Join(appDir, "app.go"), customAppFile, 0644)
+	require.NoError(t, err)
+
+	// the app doesn't implement the standard methods
+	_, err = cosmosanalysis.FindAppFilePath(tmpDir)
+	require.Error(t, err)
+
+	path, err := cosmosanalysis.FindAppFilePathByInterface(tmpDir, []string{"Name", "EndBlocker"})
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(appDir, "app.go"), path)
+
+	_, err = cosmosanalysis.FindAppFilePathByInterface(tmpDir, []string{"Name", "BeginBlocker"})
+	require.Error(t, err)
+}
+
+func TestValidateGoModVersions(t *testing.T) {
+	gomod := []byte(`
+module github.com/foo/bar
+
+require (
+	github.com/cosmos/cosmos-sdk v0.44.5
+	github.com/tendermint/tendermint v0.34.14
+)
+`)
+	module, err := modfile.Parse("go.mod", gomod, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, cosmosanalysis.ValidateGoMod(module))
+
+	err = cosmosanalysis.ValidateGoModVersions(module, map[string]string{
+		"github.com/cosmos/cosmos-sdk":     "v0.44.0",
+		"github.com/tendermint/tendermint": "0.34.14",
+	})
+	require.NoError(t, err)
+
+	err = cosmosanalysis.ValidateGoModVersions(module, map[string]string{
+		"github.com/cosmos/cosmos-sdk":     "v0.45.0",
+		"github.com/tendermint/tendermint": "v0.35.0",
+	})
+	require.EqualError(t, err, "invalid go module, outdated package dependencies: "+
+		"github.com/cosmos/cosmos-sdk v0.44.5 (minimum v0.45.0), "+
+		"github.com/tendermint/tendermint v0.34.14 (minimum v0.35.0)")
+
+	err = cosmosanalysis.ValidateGoModVersions(module, map[string]string{
+		"github.com/cosmos/ibc-go": "v2.0.0",
+	})
+	require.EqualError(t, err, "invalid go module, missing github.com/cosmos/ibc-go package dependency")
+
+	err = cosmosanalysis.ValidateGoModVersions(module, map[string]string{
+		"github.com/cosmos/cosmos-sdk": "invalid",
+	})
+	require.Error(t, err)
+}
@@ -0,0 +1,137 @@
+package cosmosanalysis
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	sdkPackageName        = "sdk"
+	newEventFuncName      = "NewEvent"
+	eventTypePrefix       = "EventType"
+	eventTypeMessage      = "EventTypeMessage"
+	eventTypeMessageValue = "message"
+)
+
+// FindEventDefinitions finds the event types emitted by the module under the module path.
+// It looks for sdk.EventTypeMessage usages, sdk.NewEvent calls and EventType* constants
+// and returns their deduplicated string values.
+func FindEventDefinitions(modulePath string) ([]string, error) {
+	var files []*ast.File
+	fset := token.NewFileSet()
+
+	err := filepath.Walk(modulePath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || filepath.Ext(path) != ".go" || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+
+		f, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return err
+		}
+		files = append(files, f)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// collect the string constants first so event types referenced by name can be resolved.
+	consts := make(map[string]string)
+	events := make(map[string]bool)
+	for _, f := range files {
+		for _, decl := range f.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.CONST {
+				continue
+			}
+			for _, spec := range genDecl.Specs {
+				valueSpec := spec.(*ast.ValueSpec)
+				for i, name := range valueSpec.Names {
+					if i >= len(valueSpec.Values) {
+						continue
+					}
+					value, ok := stringLitValue(valueSpec.Values[i])
+					if !ok {
+						continue
+					}
+					consts[name.Name] = value
+					if strings.HasPrefix(name.Name, eventTypePrefix) {
+						events[value] = true
+					}
+				}
+			}
+		}
+	}
+
+	resolve := func(expr ast.Expr) (string, bool) {
+		switch e := expr.(type) {
+		case *ast.Ident:
+			value, ok := consts[e.Name]
+			return value, ok
+		case *ast.SelectorExpr:
+			if pkg, ok := e.X.(*ast.Ident); ok && pkg.Name == sdkPackageName && e.Sel.Name == eventTypeMessage {
+				return eventTypeMessageValue, true
+			}
+			value, ok := consts[e.Sel.Name]
+			return value, ok
+		}
+		return stringLitValue(expr)
+	}
+
+	for _, f := range files {
+		ast.Inspect(f, func(n ast.Node) bool {
+			switch node := n.(type) {
+			case *ast.CallExpr:
+				// sdk.NewEvent(eventType, ...)
+				selector, ok := node.Fun.(*ast.SelectorExpr)
+				if !ok || selector.Sel.Name != newEventFuncName || len(node.Args) == 0 {
+					return true
+				}
+				if pkg, ok := selector.X.(*ast.Ident); !ok || pkg.Name != sdkPackageName {
+					return true
+				}
+				if value, ok := resolve(node.Args[0]); ok {
+					events[value] = true
+				}
+			case *ast.SelectorExpr:
+				// sdk.EventTypeMessage
+				if pkg, ok := node.X.(*ast.Ident); ok && pkg.Name == sdkPackageName && node.Sel.Name == eventTypeMessage {
+					events[eventTypeMessageValue] = true
+				}
+			}
+			return true
+		})
+	}
+
+	found := make([]string, 0, len(events))
+	for event := range events {
+		found = append(found, event)
+	}
+	sort.Strings(found)
+
+	return found, nil
+}
+
+// stringLitValue returns the value of the expression if it is a string literal.
+func stringLitValue(expr ast.Expr) (string, bool) {
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+	value, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return "", false
+	}
+	return value, true
+}
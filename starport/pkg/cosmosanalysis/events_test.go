@@ -0,0 +1,48 @@
+package cosmosanalysis_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/pkg/cosmosanalysis"
+)
+
+func TestFindEventDefinitions(t *testing.T) {
+	tmpDir := t.TempDir()
+	typesDir := filepath.Join(tmpDir, "types")
+	require.NoError(t, os.Mkdir(typesDir, 0755))
+
+	eventsFile := []byte(`
+package types
+
+const (
+	EventTypeCreatePost = "create_post"
+	EventTypeDeletePost = "delete_post"
+	AttributeKeyCreator = "creator"
+)
+`)
+	handlerFile := []byte(`
+package foo
+
+const transferEvent = "transfer"
+
+func handleMsgCreatePost(ctx sdk.Context) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(types.EventTypeCreatePost, sdk.NewAttribute(types.AttributeKeyCreator, "")),
+		sdk.NewEvent(sdk.EventTypeMessage),
+		sdk.NewEvent(transferEvent),
+		sdk.NewEvent("burn"),
+	})
+}
+`)
+	err := os.WriteFile(filepath.Join(typesDir, "events.go"), eventsFile, 0644)
+	require.NoError(t, err)
+	err = os.WriteFile(filepath.Join(tmpDir, "handler.go"), handlerFile, 0644)
+	require.NoError(t, err)
+
+	events, err := cosmosanalysis.FindEventDefinitions(tmpDir)
+	require.NoError(t, err)
+	require.Equal(t, []string{"burn", "create_post", "delete_post", "message", "transfer"}, events)
+}
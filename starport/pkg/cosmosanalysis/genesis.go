@@ -0,0 +1,115 @@
+package cosmosanalysis
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	defaultGenesisFuncName = "DefaultGenesis"
+	modulesDirName         = "x"
+)
+
+// ExtractGenesisStateTypes finds the genesis state type used by each module of the chain.
+// It looks for the DefaultGenesis() implementations of the modules and returns the name of
+// the struct type returned by them indexed by module name.
+func ExtractGenesisStateTypes(chainRoot string) (map[string]string, error) {
+	// make sure that the chain root contains a Cosmos SDK app
+	if _, err := FindAppFilePath(chainRoot); err != nil {
+		return nil, err
+	}
+
+	genesisTypes := make(map[string]string)
+	fset := token.NewFileSet()
+
+	err := filepath.Walk(chainRoot, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || filepath.Ext(path) != ".go" || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+
+		f, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return err
+		}
+
+		for _, decl := range f.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || funcDecl.Name.Name != defaultGenesisFuncName || funcDecl.Body == nil {
+				continue
+			}
+
+			typeName := findReturnedStructType(funcDecl.Body)
+			if typeName == "" {
+				continue
+			}
+
+			rel, err := filepath.Rel(chainRoot, path)
+			if err != nil {
+				return err
+			}
+			genesisTypes[moduleNameFromPath(rel, f.Name.Name)] = typeName
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return genesisTypes, nil
+}
+
+// findReturnedStructType returns the name of the first struct type returned as a composite
+// literal inside the function body.
+func findReturnedStructType(body *ast.BlockStmt) (typeName string) {
+	ast.Inspect(body, func(n ast.Node) bool {
+		if typeName != "" {
+			return false
+		}
+
+		returnStmt, ok := n.(*ast.ReturnStmt)
+		if !ok || len(returnStmt.Results) == 0 {
+			return true
+		}
+
+		result := returnStmt.Results[0]
+		if unary, ok := result.(*ast.UnaryExpr); ok && unary.Op == token.AND {
+			result = unary.X
+		}
+
+		compositeLit, ok := result.(*ast.CompositeLit)
+		if !ok {
+			return true
+		}
+
+		switch t := compositeLit.Type.(type) {
+		case *ast.Ident:
+			typeName = t.Name
+		case *ast.SelectorExpr:
+			typeName = t.Sel.Name
+		}
+
+		return false
+	})
+
+	return typeName
+}
+
+// moduleNameFromPath returns the name of the module that the file in the relative path belongs to.
+// Modules are expected to live under the x/ directory, otherwise the package name is used.
+func moduleNameFromPath(relPath, pkgName string) string {
+	parts := strings.Split(filepath.ToSlash(relPath), "/")
+	for i, part := range parts[:len(parts)-1] {
+		if part == modulesDirName && i+1 < len(parts)-1 {
+			return parts[i+1]
+		}
+	}
+	return pkgName
+}
@@ -0,0 +1,49 @@
+package cosmosanalysis_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/pkg/cosmosanalysis"
+)
+
+func TestExtractGenesisStateTypes(t *testing.T) {
+	tmpDir := t.TempDir()
+	appDir := filepath.Join(tmpDir, "app")
+	typesDir := filepath.Join(tmpDir, "x", "foo", "types")
+	require.NoError(t, os.MkdirAll(appDir, 0755))
+	require.NoError(t, os.MkdirAll(typesDir, 0755))
+
+	// missing app
+	_, err := cosmosanalysis.ExtractGenesisStateTypes(tmpDir)
+	require.Error(t, err)
+
+	genesisFile := []byte(`
+package types
+
+type GenesisState struct {}
+
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{}
+}
+`)
+	moduleFile := []byte(`
+package foo
+
+func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(types.DefaultGenesis())
+}
+`)
+	err = os.WriteFile(filepath.Join(appDir, "app.go"), appFile, 0644)
+	require.NoError(t, err)
+	err = os.WriteFile(filepath.Join(typesDir, "genesis.go"), genesisFile, 0644)
+	require.NoError(t, err)
+	err = os.WriteFile(filepath.Join(tmpDir, "x", "foo", "module.go"), moduleFile, 0644)
+	require.NoError(t, err)
+
+	genesisTypes, err := cosmosanalysis.ExtractGenesisStateTypes(tmpDir)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"foo": "GenesisState"}, genesisTypes)
+}
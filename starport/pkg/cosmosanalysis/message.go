@@ -0,0 +1,33 @@
+package cosmosanalysis
+
+import (
+	"io/fs"
+	"sort"
+	"strings"
+)
+
+const protoGoFileSuffix = ".pb.go"
+
+// msgImplementation is the list of methods needed for a sdk.Msg implementation
+// TODO(low priority): dynamically get these from the source code of underlying version of the sdk.
+var msgImplementation = []string{
+	"Route",
+	"Type",
+	"GetSigners",
+	"GetSignBytes",
+	"ValidateBasic",
+}
+
+// FindMsgTypes finds the name of all types under the module path that implement sdk.Msg.
+// Generated protobuf files are skipped.
+func FindMsgTypes(modulePath string) ([]string, error) {
+	msgs, err := findImplementation(modulePath, msgImplementation, AnyReceiver, func(info fs.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), protoGoFileSuffix)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(msgs)
+	return msgs, nil
+}
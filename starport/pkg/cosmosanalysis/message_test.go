@@ -0,0 +1,50 @@
+package cosmosanalysis_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/pkg/cosmosanalysis"
+)
+
+func TestFindMsgTypes(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	msgFile := []byte(`
+package types
+
+type MsgCreatePost struct {}
+func (msg *MsgCreatePost) Route() string { return "" }
+func (msg *MsgCreatePost) Type() string { return "" }
+func (msg *MsgCreatePost) GetSigners() {}
+func (msg *MsgCreatePost) GetSignBytes() []byte { return nil }
+func (msg *MsgCreatePost) ValidateBasic() error { return nil }
+
+type MsgDeletePost struct {}
+func (msg MsgDeletePost) Route() string { return "" }
+func (msg MsgDeletePost) Type() string { return "" }
+func (msg MsgDeletePost) GetSigners() {}
+func (msg MsgDeletePost) GetSignBytes() []byte { return nil }
+func (msg MsgDeletePost) ValidateBasic() error { return nil }
+`)
+	protoFile := []byte(`
+package types
+
+type MsgGenerated struct {}
+func (msg *MsgGenerated) Route() string { return "" }
+func (msg *MsgGenerated) Type() string { return "" }
+func (msg *MsgGenerated) GetSigners() {}
+func (msg *MsgGenerated) GetSignBytes() []byte { return nil }
+func (msg *MsgGenerated) ValidateBasic() error { return nil }
+`)
+	err := os.WriteFile(filepath.Join(tmpDir, "msgs.go"), msgFile, 0644)
+	require.NoError(t, err)
+	err = os.WriteFile(filepath.Join(tmpDir, "tx.pb.go"), protoFile, 0644)
+	require.NoError(t, err)
+
+	msgs, err := cosmosanalysis.FindMsgTypes(tmpDir)
+	require.NoError(t, err)
+	require.Equal(t, []string{"MsgCreatePost", "MsgDeletePost"}, msgs)
+}
@@ -121,7 +121,7 @@ func (d *moduleDiscoverer) discover(pkg protoanalysis.Package) (Module, error) {
 	pkgrelpath := strings.TrimPrefix(pkg.GoImportPath(), d.basegopath)
 	pkgpath := filepath.Join(d.sourcePath, pkgrelpath)
 
-	msgs, err := cosmosanalysis.FindImplementation(pkgpath, messageImplementation)
+	msgs, err := cosmosanalysis.FindMsgTypes(pkgpath)
 	if err != nil {
 		return Module{}, err
 	}
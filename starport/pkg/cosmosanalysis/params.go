@@ -0,0 +1,99 @@
+package cosmosanalysis
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+)
+
+const newSubspaceFuncName = "NewSubspace"
+
+// paramSubspacePackages is the list of package names that provide the NewSubspace function
+var paramSubspacePackages = []string{
+	"paramtypes",
+	"paramstore",
+}
+
+// FindParamSubspaces finds the param subspaces created under the chain root.
+// It returns the subspace names indexed by the name of the struct that creates them,
+// when the subspace is not created within a method the function name is used instead.
+func FindParamSubspaces(chainRoot string) (map[string]string, error) {
+	subspaces := make(map[string]string)
+	fset := token.NewFileSet()
+
+	err := filepath.Walk(chainRoot, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || filepath.Ext(path) != ".go" {
+			return nil
+		}
+
+		f, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return err
+		}
+
+		for _, decl := range f.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || funcDecl.Body == nil {
+				continue
+			}
+
+			owner := funcDecl.Name.Name
+			if funcDecl.Recv != nil {
+				if name, _, ok := receiverTypeName(funcDecl.Recv.List[0].Type); ok {
+					owner = name
+				}
+			}
+
+			ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+				callExpr, ok := n.(*ast.CallExpr)
+				if !ok || !isNewSubspaceCall(callExpr) {
+					return true
+				}
+				if name, ok := firstStringArg(callExpr); ok {
+					subspaces[name] = owner
+				}
+				return true
+			})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return subspaces, nil
+}
+
+// isNewSubspaceCall checks if the call expression creates a new param subspace.
+func isNewSubspaceCall(callExpr *ast.CallExpr) bool {
+	selector, ok := callExpr.Fun.(*ast.SelectorExpr)
+	if !ok || selector.Sel.Name != newSubspaceFuncName {
+		return false
+	}
+	pkg, ok := selector.X.(*ast.Ident)
+	if !ok {
+		return false
+	}
+	for _, name := range paramSubspacePackages {
+		if pkg.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// firstStringArg returns the value of the first string literal argument of the call expression.
+func firstStringArg(callExpr *ast.CallExpr) (string, bool) {
+	for _, arg := range callExpr.Args {
+		if value, ok := stringLitValue(arg); ok {
+			return value, true
+		}
+	}
+	return "", false
+}
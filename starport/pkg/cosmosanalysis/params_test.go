@@ -0,0 +1,41 @@
+package cosmosanalysis_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/pkg/cosmosanalysis"
+)
+
+func TestFindParamSubspaces(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	file := []byte(`
+package foo
+
+type Keeper struct {}
+
+func (k *Keeper) init() {
+	k.subspace = paramtypes.NewSubspace(cdc, amino, key, tkey, "foo")
+}
+
+func newBarSubspace() {
+	return paramstore.NewSubspace(cdc, amino, key, tkey, "bar")
+}
+
+func other() {
+	return types.NewSubspace("baz")
+}
+`)
+	err := os.WriteFile(filepath.Join(tmpDir, "keeper.go"), file, 0644)
+	require.NoError(t, err)
+
+	subspaces, err := cosmosanalysis.FindParamSubspaces(tmpDir)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"foo": "Keeper",
+		"bar": "newBarSubspace",
+	}, subspaces)
+}
@@ -0,0 +1,134 @@
+package cosmosanalysis
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// queryHandlerReceiverSuffixes is the list of name suffixes of types that implement query handlers
+var queryHandlerReceiverSuffixes = []string{
+	"queryserver",
+	"querier",
+}
+
+// QueryHandler keeps metadata about a gRPC query handler method.
+type QueryHandler struct {
+	// StructName is the name of the type that implements the handler.
+	StructName string
+
+	// MethodName is the name of the handler method.
+	MethodName string
+
+	// RequestType is the name of the query request type.
+	RequestType string
+
+	// ResponseType is the name of the query response type.
+	ResponseType string
+}
+
+// FindQueryHandlers finds the gRPC query handlers under the module path.
+// Handlers are the methods of *QueryServer and *Querier types with the
+// func(context, *Request) (*Response, error) signature.
+func FindQueryHandlers(modulePath string) ([]QueryHandler, error) {
+	var handlers []QueryHandler
+	fset := token.NewFileSet()
+
+	err := filepath.Walk(modulePath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || filepath.Ext(path) != ".go" || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+
+		f, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return err
+		}
+
+		for _, decl := range f.Decls {
+			funcDecl, ok := decl.(*ast.FuncDecl)
+			if !ok || funcDecl.Recv == nil {
+				continue
+			}
+
+			structName, _, ok := receiverTypeName(funcDecl.Recv.List[0].Type)
+			if !ok || !isQueryHandlerReceiver(structName) {
+				continue
+			}
+
+			params := fieldTypes(funcDecl.Type.Params)
+			results := fieldTypes(funcDecl.Type.Results)
+			if len(params) != 2 || len(results) != 2 {
+				continue
+			}
+
+			handlers = append(handlers, QueryHandler{
+				StructName:   structName,
+				MethodName:   funcDecl.Name.Name,
+				RequestType:  typeName(params[1]),
+				ResponseType: typeName(results[0]),
+			})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(handlers, func(i, j int) bool {
+		if handlers[i].StructName != handlers[j].StructName {
+			return handlers[i].StructName < handlers[j].StructName
+		}
+		return handlers[i].MethodName < handlers[j].MethodName
+	})
+
+	return handlers, nil
+}
+
+// isQueryHandlerReceiver checks if the type name matches the query handler type name patterns.
+func isQueryHandlerReceiver(name string) bool {
+	name = strings.ToLower(name)
+	for _, suffix := range queryHandlerReceiverSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldTypes returns the type of each field in the list, fields that share a type are expanded.
+func fieldTypes(fields *ast.FieldList) (types []ast.Expr) {
+	if fields == nil {
+		return nil
+	}
+	for _, field := range fields.List {
+		count := len(field.Names)
+		if count == 0 {
+			count = 1
+		}
+		for i := 0; i < count; i++ {
+			types = append(types, field.Type)
+		}
+	}
+	return types
+}
+
+// typeName returns the name of the type without its pointer and package qualifier.
+func typeName(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		return typeName(t.X)
+	case *ast.SelectorExpr:
+		return t.Sel.Name
+	case *ast.Ident:
+		return t.Name
+	}
+	return ""
+}
@@ -0,0 +1,52 @@
+package cosmosanalysis_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/pkg/cosmosanalysis"
+)
+
+func TestFindQueryHandlers(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	file := []byte(`
+package keeper
+
+type queryServer struct {}
+
+func (q queryServer) Posts(c context.Context, req *types.QueryPostsRequest) (*types.QueryPostsResponse, error) {
+	return nil, nil
+}
+
+func (q *queryServer) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	return nil, nil
+}
+
+func (q queryServer) helper() {}
+
+type Querier struct {}
+
+func (q Querier) Post(c context.Context, req *QueryPostRequest) (res *QueryPostResponse, err error) {
+	return nil, nil
+}
+
+type Keeper struct {}
+
+func (k Keeper) Get(c context.Context, req *types.QueryGetRequest) (*types.QueryGetResponse, error) {
+	return nil, nil
+}
+`)
+	err := os.WriteFile(filepath.Join(tmpDir, "grpc_query.go"), file, 0644)
+	require.NoError(t, err)
+
+	handlers, err := cosmosanalysis.FindQueryHandlers(tmpDir)
+	require.NoError(t, err)
+	require.Equal(t, []cosmosanalysis.QueryHandler{
+		{StructName: "Querier", MethodName: "Post", RequestType: "QueryPostRequest", ResponseType: "QueryPostResponse"},
+		{StructName: "queryServer", MethodName: "Params", RequestType: "QueryParamsRequest", ResponseType: "QueryParamsResponse"},
+		{StructName: "queryServer", MethodName: "Posts", RequestType: "QueryPostsRequest", ResponseType: "QueryPostsResponse"},
+	}, handlers)
+}
@@ -0,0 +1,90 @@
+package cosmosanalysis
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/tendermint/starport/starport/pkg/cosmosver"
+	"github.com/tendermint/starport/starport/pkg/gomodule"
+)
+
+const (
+	// nftModulePath is the import path of the nft module, introduced in the Cosmos SDK v0.46
+	nftModulePath = cosmosModulePath + "/x/nft"
+
+	// nftMinSDKVersion is the first Cosmos SDK version including the nft module
+	nftMinSDKVersion = "v0.46.0"
+)
+
+// pseudoVersionRe matches the pseudo-versions of Go modules, as defined by the go command
+var pseudoVersionRe = regexp.MustCompile(`^v[0-9]+\.(0\.0-|\d+\.\d+-([^+]*\.)?0\.)\d{14}-[A-Za-z0-9]+(\+[0-9A-Za-z-]+(\.[0-9A-Za-z-]+)*)?$`)
+
+// DetectSDKVersion detects the version of the Cosmos SDK used by the chain from its go.mod,
+// the version of a replaced Cosmos SDK module is used when the replacement is versioned.
+// Pseudo-versions don't always reflect the version of the Cosmos SDK, a pseudo-version is
+// considered as v0.46 at least when the chain imports the nft module, the exact pseudo-version
+// is kept in the Version field of the returned version.
+func DetectSDKVersion(chainRoot string) (cosmosver.Version, error) {
+	gm, err := gomodule.ParseAt(chainRoot)
+	if err != nil {
+		return cosmosver.Version{}, err
+	}
+
+	var version string
+	for _, r := range gm.Require {
+		if r.Mod.Path == cosmosModulePath {
+			version = r.Mod.Version
+		}
+	}
+	if version == "" {
+		return cosmosver.Version{}, fmt.Errorf("invalid go module, missing %s package dependency", cosmosModulePath)
+	}
+	for _, r := range gm.Replace {
+		// replacements with a local path have no version
+		if r.Old.Path == cosmosModulePath && r.New.Version != "" {
+			version = r.New.Version
+		}
+	}
+
+	v, err := cosmosver.Parse(version)
+	if err != nil {
+		return cosmosver.Version{}, fmt.Errorf("invalid %s version %s: %w", cosmosModulePath, version, err)
+	}
+	if !pseudoVersionRe.MatchString(version) {
+		return v, nil
+	}
+
+	nftVersion, err := cosmosver.Parse(nftMinSDKVersion)
+	if err != nil {
+		return cosmosver.Version{}, err
+	}
+	if v.GTE(nftVersion) {
+		return v, nil
+	}
+	importsNFT, err := importsPackage(chainRoot, nftModulePath)
+	if err != nil {
+		return cosmosver.Version{}, err
+	}
+	if importsNFT {
+		nftVersion.Version = version
+		return nftVersion, nil
+	}
+	return v, nil
+}
+
+// importsPackage checks if a Go source file of the chain imports the package.
+func importsPackage(chainRoot, pkgPath string) (bool, error) {
+	files, err := parseSourceFiles(chainRoot)
+	if err != nil {
+		return false, err
+	}
+	for _, f := range files {
+		for _, imp := range f.file.Imports {
+			if path, err := strconv.Unquote(imp.Path.Value); err == nil && path == pkgPath {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,91 @@
+package cosmosanalysis_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/pkg/cosmosanalysis"
+	"github.com/tendermint/starport/starport/pkg/cosmosver"
+)
+
+func TestDetectSDKVersion(t *testing.T) {
+	const nftAppFile = `
+package app
+
+import _ "github.com/cosmos/cosmos-sdk/x/nft"
+`
+
+	tests := []struct {
+		name     string
+		goMod    string
+		appFile  string
+		version  string
+		semantic string
+		family   cosmosver.Family
+		err      bool
+	}{
+		{
+			name:     "release version",
+			goMod:    "module foo\n\nrequire github.com/cosmos/cosmos-sdk v0.44.5\n",
+			version:  "v0.44.5",
+			semantic: "0.44.5",
+			family:   cosmosver.Stargate,
+		},
+		{
+			name:     "launchpad version",
+			goMod:    "module foo\n\nrequire github.com/cosmos/cosmos-sdk v0.39.2\n",
+			version:  "v0.39.2",
+			semantic: "0.39.2",
+			family:   cosmosver.Launchpad,
+		},
+		{
+			name:     "replaced version",
+			goMod:    "module foo\n\nrequire github.com/cosmos/cosmos-sdk v0.44.5\n\nreplace github.com/cosmos/cosmos-sdk => github.com/foo/cosmos-sdk v0.45.0\n",
+			version:  "v0.45.0",
+			semantic: "0.45.0",
+			family:   cosmosver.Stargate,
+		},
+		{
+			name:     "pseudo-version",
+			goMod:    "module foo\n\nrequire github.com/cosmos/cosmos-sdk v0.44.6-0.20220101120000-abcdefabcdef\n",
+			version:  "v0.44.6-0.20220101120000-abcdefabcdef",
+			semantic: "0.44.6-0.20220101120000-abcdefabcdef",
+			family:   cosmosver.Stargate,
+		},
+		{
+			name:     "pseudo-version with nft module",
+			goMod:    "module foo\n\nrequire github.com/cosmos/cosmos-sdk v0.0.0-20220101120000-abcdefabcdef\n",
+			appFile:  nftAppFile,
+			version:  "v0.0.0-20220101120000-abcdefabcdef",
+			semantic: "0.46.0",
+			family:   cosmosver.Stargate,
+		},
+		{
+			name:    "missing dependency",
+			goMod:   "module foo\n",
+			appFile: nftAppFile,
+			err:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "go.mod"), []byte(tt.goMod), 0644))
+			if tt.appFile != "" {
+				require.NoError(t, os.WriteFile(filepath.Join(tmpDir, "app.go"), []byte(tt.appFile), 0644))
+			}
+
+			v, err := cosmosanalysis.DetectSDKVersion(tmpDir)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.version, v.Version)
+			require.Equal(t, tt.semantic, v.Semantic.String())
+			require.Equal(t, tt.family, v.Family)
+		})
+	}
+}
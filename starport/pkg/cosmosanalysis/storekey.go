@@ -0,0 +1,125 @@
+package cosmosanalysis
+
+import (
+	"fmt"
+	"go/ast"
+)
+
+var (
+	// storeKeyFuncNames are the Cosmos SDK functions creating a store key of a chain
+	storeKeyFuncNames = map[string]bool{
+		"NewKVStoreKey":        true,
+		"NewTransientStoreKey": true,
+		"NewMemoryStoreKey":    true,
+	}
+
+	// storeKeysFuncNames are the Cosmos SDK functions creating a map of store keys of a chain
+	storeKeysFuncNames = map[string]bool{
+		"NewKVStoreKeys":        true,
+		"NewTransientStoreKeys": true,
+		"NewMemoryStoreKeys":    true,
+	}
+)
+
+// FindStoreKeys finds the KV, transient and memory store keys created in the chain with the
+// sdk.NewKVStoreKey, sdk.NewTransientStoreKey and sdk.NewMemoryStoreKey functions and their plural
+// versions creating maps of keys. keys are returned indexed by the name of the variable they are
+// assigned to, the name of the field is used for keys assigned to struct fields. the keys of a map
+// are indexed by their map access expression, e.g. keys["bank"] for the bank key of the keys map.
+// keys can be string literals or string constants declared by the chain or by its dependencies.
+func FindStoreKeys(chainRoot string) (map[string]string, error) {
+	files, err := parseSourceFiles(chainRoot)
+	if err != nil {
+		return nil, err
+	}
+	consts, err := newConstResolver(chainRoot, files)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]string)
+	for _, f := range files {
+		f := f
+		addKeys := func(names []ast.Expr, values []ast.Expr) {
+			if len(names) != len(values) {
+				return
+			}
+			for i, value := range values {
+				name, ok := assignedName(names[i])
+				if !ok {
+					continue
+				}
+				for _, key := range storeKeyValues(value, consts, f) {
+					if key.isMap {
+						keys[fmt.Sprintf("%s[%q]", name, key.value)] = key.value
+					} else {
+						keys[name] = key.value
+					}
+				}
+			}
+		}
+
+		ast.Inspect(f.file, func(n ast.Node) bool {
+			switch node := n.(type) {
+			case *ast.ValueSpec:
+				// var storeKey = sdk.NewKVStoreKey("foo")
+				names := make([]ast.Expr, 0, len(node.Names))
+				for _, name := range node.Names {
+					names = append(names, name)
+				}
+				addKeys(names, node.Values)
+			case *ast.AssignStmt:
+				// storeKey := sdk.NewKVStoreKey("foo") or app.storeKey = sdk.NewKVStoreKey("foo")
+				addKeys(node.Lhs, node.Rhs)
+			}
+			return true
+		})
+	}
+
+	return keys, nil
+}
+
+// storeKey is a store key created by a call.
+type storeKey struct {
+	value string
+
+	// isMap is true when the key is created within a map of keys.
+	isMap bool
+}
+
+// storeKeyValues returns the keys of the stores when the expression is a call creating store keys.
+func storeKeyValues(expr ast.Expr, consts *constResolver, f sourceFile) (keys []storeKey) {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok {
+		return nil
+	}
+	selector, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return nil
+	}
+
+	switch name := selector.Sel.Name; {
+	case storeKeyFuncNames[name] && len(call.Args) == 1:
+		if value, ok := consts.resolve(call.Args[0], f); ok {
+			keys = append(keys, storeKey{value: value})
+		}
+	case storeKeysFuncNames[name]:
+		for _, arg := range call.Args {
+			if value, ok := consts.resolve(arg, f); ok {
+				keys = append(keys, storeKey{value: value, isMap: true})
+			}
+		}
+	}
+	return keys
+}
+
+// assignedName returns the name of the variable or field an expression is assigned to.
+func assignedName(expr ast.Expr) (string, bool) {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return e.Name, e.Name != "_"
+	case *ast.SelectorExpr:
+		return e.Sel.Name, true
+	}
+	return "", false
+}
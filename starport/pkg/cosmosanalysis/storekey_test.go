@@ -0,0 +1,79 @@
+package cosmosanalysis_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/pkg/cosmosanalysis"
+)
+
+func TestFindStoreKeys(t *testing.T) {
+	tmpDir := t.TempDir()
+	typesDir := filepath.Join(tmpDir, "x", "foo", "types")
+	require.NoError(t, os.MkdirAll(typesDir, 0755))
+
+	goMod := []byte(`module github.com/test/foo`)
+	keysFile := []byte(`
+package types
+
+const StoreKey = "foo"
+`)
+	appFile := []byte(`
+package app
+
+import footypes "github.com/test/foo/x/foo/types"
+
+const StoreKey = "app"
+
+var paramsKey = sdk.NewKVStoreKey("params")
+
+func New() *App {
+	fooKey := sdk.NewKVStoreKey(footypes.StoreKey)
+	appKey := sdk.NewKVStoreKey(StoreKey)
+	app.tkeyParams = sdk.NewTransientStoreKey("transient_" + "params")
+	_ = sdk.NewKVStoreKey("ignored")
+	keys := sdk.NewKVStoreKeys("bank", "staking")
+	return app
+}
+`)
+	err := os.WriteFile(filepath.Join(tmpDir, "go.mod"), goMod, 0644)
+	require.NoError(t, err)
+	err = os.WriteFile(filepath.Join(typesDir, "keys.go"), keysFile, 0644)
+	require.NoError(t, err)
+	err = os.WriteFile(filepath.Join(tmpDir, "app.go"), appFile, 0644)
+	require.NoError(t, err)
+
+	keys, err := cosmosanalysis.FindStoreKeys(tmpDir)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"paramsKey":       "params",
+		"fooKey":          "foo",
+		"appKey":          "app",
+		"tkeyParams":      "transient_params",
+		`keys["bank"]`:    "bank",
+		`keys["staking"]`: "staking",
+	}, keys)
+}
+
+func TestFindStoreKeysScaffoldedChain(t *testing.T) {
+	// the chain reproduces the store keys of a chain scaffolded with a mars module,
+	// the keys of the Cosmos SDK and IBC modules are resolved from the Go module cache.
+	keys, err := cosmosanalysis.FindStoreKeys("testdata/storekeys")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		`keys["acc"]`:               "acc",
+		`keys["bank"]`:              "bank",
+		`keys["params"]`:            "params",
+		`keys["ibc"]`:               "ibc",
+		`keys["feegrant"]`:          "feegrant",
+		`keys["capability"]`:        "capability",
+		`keys["mars"]`:              "mars",
+		`tkeys["transient_params"]`: "transient_params",
+		`memKeys["mem_capability"]`: "mem_capability",
+		"storeKey":                  "mars",
+		"memStoreKey":               "mem_mars",
+		"keeperKey":                 "keeper",
+	}, keys)
+}
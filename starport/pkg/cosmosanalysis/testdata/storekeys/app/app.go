@@ -0,0 +1,30 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	ibchost "github.com/cosmos/ibc-go/v2/modules/core/24-host"
+	marsmoduletypes "github.com/test/mars/x/mars/types"
+)
+
+func New() *App {
+	keys := sdk.NewKVStoreKeys(
+		authtypes.StoreKey, banktypes.StoreKey, paramstypes.StoreKey,
+		ibchost.StoreKey, feegrant.StoreKey, capabilitytypes.StoreKey,
+		marsmoduletypes.StoreKey,
+		// this line is used by starport scaffolding # stargate/app/storeKey
+	)
+	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
+	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
+
+	app := &App{
+		keys:    keys,
+		tkeys:   tkeys,
+		memKeys: memKeys,
+	}
+	return app
+}
@@ -0,0 +1,8 @@
+module github.com/test/mars
+
+go 1.16
+
+require (
+	github.com/cosmos/cosmos-sdk v0.44.5
+	github.com/cosmos/ibc-go/v2 v2.0.2
+)
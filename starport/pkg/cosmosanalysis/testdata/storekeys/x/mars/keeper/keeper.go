@@ -0,0 +1,14 @@
+package keeper
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/test/mars/x/mars/types"
+)
+
+func NewTestKeeper() {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	memStoreKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
+	keeperKey := sdk.NewKVStoreKey(StoreKey)
+	_, _, _ = storeKey, memStoreKey, keeperKey
+}
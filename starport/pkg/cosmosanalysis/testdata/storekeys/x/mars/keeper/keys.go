@@ -0,0 +1,4 @@
+package keeper
+
+// StoreKey has the same name as the store key of the module types but another value
+const StoreKey = "keeper"
@@ -0,0 +1,95 @@
+package cosmosanalysis
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const setUpgradeHandlerFuncName = "SetUpgradeHandler"
+
+// FindUpgradeHandlers finds the names of the upgrade handlers registered in the chain.
+// It looks for upgradekeeper.SetUpgradeHandler calls and returns their sorted and
+// deduplicated name arguments, names can be string literals or string constants of the chain and its dependencies.
+func FindUpgradeHandlers(chainRoot string) ([]string, error) {
+	files, err := parseSourceFiles(chainRoot)
+	if err != nil {
+		return nil, err
+	}
+	consts, err := newConstResolver(chainRoot, files)
+	if err != nil {
+		return nil, err
+	}
+
+	handlers := make(map[string]bool)
+	for _, f := range files {
+		f := f
+		ast.Inspect(f.file, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			selector, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok || selector.Sel.Name != setUpgradeHandlerFuncName {
+				return true
+			}
+
+			// the name is the first string argument of the call, some keepers take a context first
+			for _, arg := range call.Args {
+				if name, ok := consts.resolve(arg, f); ok {
+					handlers[name] = true
+					break
+				}
+			}
+			return true
+		})
+	}
+
+	found := make([]string, 0, len(handlers))
+	for name := range handlers {
+		found = append(found, name)
+	}
+	sort.Strings(found)
+
+	return found, nil
+}
+
+// parseSourceFiles parses the non-test Go files under root, skipping vendor and testdata directories.
+func parseSourceFiles(root string) ([]sourceFile, error) {
+	var files []sourceFile
+	fset := token.NewFileSet()
+
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			for _, dir := range defaultExcludedDirs {
+				if path != root && info.Name() == dir {
+					return filepath.SkipDir
+				}
+			}
+			return nil
+		}
+		if filepath.Ext(path) != ".go" || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+
+		f, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return err
+		}
+		files = append(files, sourceFile{dir: filepath.Dir(path), file: f})
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
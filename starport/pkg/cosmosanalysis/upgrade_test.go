@@ -0,0 +1,59 @@
+package cosmosanalysis_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/pkg/cosmosanalysis"
+)
+
+func TestFindUpgradeHandlers(t *testing.T) {
+	tmpDir := t.TempDir()
+	upgradesDir := filepath.Join(tmpDir, "app", "upgrades")
+	require.NoError(t, os.MkdirAll(upgradesDir, 0755))
+	vendorDir := filepath.Join(tmpDir, "vendor")
+	require.NoError(t, os.Mkdir(vendorDir, 0755))
+
+	goMod := []byte(`module github.com/test/foo`)
+	upgradesFile := []byte(`
+package upgrades
+
+const UpgradeName = "v2"
+`)
+	appFile := []byte(`
+package app
+
+import "github.com/test/foo/app/upgrades"
+
+// UpgradeName has the same name as the upgrade name of the upgrades package
+const UpgradeName = "unused"
+
+func (app *App) setupUpgradeHandlers() {
+	app.UpgradeKeeper.SetUpgradeHandler(upgrades.UpgradeName, upgrades.CreateUpgradeHandler(app.mm))
+	app.UpgradeKeeper.SetUpgradeHandler("v3", func(ctx sdk.Context, plan upgradetypes.Plan) {})
+	app.UpgradeKeeper.SetUpgradeHandler(ctx, "v3", nil)
+	app.UpgradeKeeper.SetUpgradeHandler(upgradeName(), nil)
+}
+`)
+	vendorFile := []byte(`
+package keeper
+
+func init() {
+	k.SetUpgradeHandler("vendored", nil)
+}
+`)
+	err := os.WriteFile(filepath.Join(tmpDir, "go.mod"), goMod, 0644)
+	require.NoError(t, err)
+	err = os.WriteFile(filepath.Join(upgradesDir, "upgrades.go"), upgradesFile, 0644)
+	require.NoError(t, err)
+	err = os.WriteFile(filepath.Join(tmpDir, "app", "app.go"), appFile, 0644)
+	require.NoError(t, err)
+	err = os.WriteFile(filepath.Join(vendorDir, "keeper.go"), vendorFile, 0644)
+	require.NoError(t, err)
+
+	handlers, err := cosmosanalysis.FindUpgradeHandlers(tmpDir)
+	require.NoError(t, err)
+	require.Equal(t, []string{"v2", "v3"}, handlers)
+}
@@ -248,6 +248,12 @@ func (c Client) BroadcastTx(accountName string, msgs ...sdktypes.Msg) (Response,
 	return broadcast()
 }
 
+// SimulateTx simulates a tx with given messages for account and returns the estimated gas without broadcasting it.
+func (c Client) SimulateTx(accountName string, msgs ...sdktypes.Msg) (gas uint64, err error) {
+	gas, _, err = c.BroadcastTxWithProvision(accountName, msgs...)
+	return gas, err
+}
+
 // protects sdktypes.Config.
 var mconf sync.Mutex
 
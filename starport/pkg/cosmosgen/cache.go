@@ -0,0 +1,157 @@
+package cosmosgen
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/tendermint/starport/starport/pkg/cosmosanalysis/module"
+	nodetimedata "github.com/tendermint/starport/starport/pkg/nodetime/data"
+	protocdata "github.com/tendermint/starport/starport/pkg/protoc/data"
+)
+
+// generationCacheFileName is the name of the file that keeps the checksums of the proto files and
+// the other generation inputs that were used to generate the code placed in the same dir.
+const generationCacheFileName = ".cosmosgen-cache.json"
+
+// generationCache is a module proto package name-checksum pair.
+type generationCache map[string]string
+
+var (
+	onceToolsChecksum sync.Once
+	toolsChecksumHex  string
+)
+
+// toolsChecksum returns the checksum of the bundled protoc and nodetime binaries, the latter
+// contains the ts-proto, sta and tsc programs. upgrading one of these changes the generated code.
+func toolsChecksum() string {
+	onceToolsChecksum.Do(func() {
+		h := sha256.New()
+		h.Write(protocdata.Binary())
+		h.Write(nodetimedata.Binary())
+		toolsChecksumHex = fmt.Sprintf("%x", h.Sum(nil))
+	})
+	return toolsChecksumHex
+}
+
+// moduleChecksum calculates the sha256 checksum of the module's proto files and the other
+// inputs of its code generation, the code of the module is regenerated when one of them changes.
+func moduleChecksum(m module.Module, inputs ...string) (string, error) {
+	h := sha256.New()
+	if err := hashFiles(h, m.Pkg.Files.Paths(), filepath.Base); err != nil {
+		return "", err
+	}
+	for _, input := range inputs {
+		fmt.Fprintf(h, "%s\n", input)
+	}
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
+}
+
+// protoDirsChecksum calculates the sha256 checksum of the proto files found inside dirs.
+func protoDirsChecksum(dirs []string) (string, error) {
+	h := sha256.New()
+	for _, dir := range dirs {
+		var paths []string
+		err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if !d.IsDir() && filepath.Ext(path) == ".proto" {
+				paths = append(paths, path)
+			}
+			return nil
+		})
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+
+		fmt.Fprintf(h, "%s\n", dir)
+		err = hashFiles(h, paths, func(path string) string {
+			rel, _ := filepath.Rel(dir, path)
+			return filepath.ToSlash(rel)
+		})
+		if err != nil {
+			return "", err
+		}
+	}
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
+}
+
+// hashFiles writes the names and the contents of the files at paths into h in a stable order,
+// name returns the name of a file written for its path.
+func hashFiles(h io.Writer, paths []string, name func(path string) string) error {
+	paths = append([]string(nil), paths...)
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(h, "%s\n", name(path))
+		_, err = io.Copy(h, f)
+		f.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readGenerationCache reads the generation cache from the out dir.
+// an empty cache is returned when there is no cache file yet.
+func readGenerationCache(out string) (generationCache, error) {
+	cache := make(generationCache)
+
+	content, err := os.ReadFile(filepath.Join(out, generationCacheFileName))
+	if os.IsNotExist(err) {
+		return cache, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(content, &cache); err != nil {
+		return nil, err
+	}
+	return cache, nil
+}
+
+// isModuleCached checks if the code generated for the module in the out dir is up to date with checksum,
+// modules are never considered as cached when the generator forces the regeneration.
+func (g *generator) isModuleCached(out string, m module.Module, checksum string) (bool, error) {
+	if g.o.forceRegenerate {
+		return false, nil
+	}
+
+	cache, err := readGenerationCache(out)
+	if err != nil {
+		return false, err
+	}
+	return cache[m.Pkg.Name] == checksum, nil
+}
+
+// cacheModule saves the checksum of the module's generation inputs to the generation cache in the out dir.
+func cacheModule(out string, m module.Module, checksum string) error {
+	cache, err := readGenerationCache(out)
+	if err != nil {
+		return err
+	}
+	cache[m.Pkg.Name] = checksum
+
+	content, err := json.MarshalIndent(cache, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(out, generationCacheFileName), content, 0644)
+}
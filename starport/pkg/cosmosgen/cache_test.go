@@ -0,0 +1,111 @@
+package cosmosgen
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/pkg/cosmosanalysis/module"
+	"github.com/tendermint/starport/starport/pkg/protoanalysis"
+)
+
+func newTestModule(t *testing.T) module.Module {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "foo.proto")
+	require.NoError(t, os.WriteFile(path, []byte(`syntax = "proto3";
+package foo.bar;
+`), 0644))
+
+	return module.Module{
+		Name: "bar",
+		Pkg: protoanalysis.Package{
+			Name:  "foo.bar",
+			Path:  dir,
+			Files: protoanalysis.Files{{Path: path}},
+		},
+	}
+}
+
+// moduleJSChecksum returns the checksum used to cache the JS code of the module generated with options.
+func moduleJSChecksum(t *testing.T, m module.Module, options ...Option) string {
+	g, err := newGenerator(context.Background(), t.TempDir(), defaultProtoDir, options...)
+	require.NoError(t, err)
+
+	inputs, err := newJSGenerator(g).generationInputs()
+	require.NoError(t, err)
+
+	checksum, err := moduleChecksum(m, inputs...)
+	require.NoError(t, err)
+	return checksum
+}
+
+func TestJSGenerationCache(t *testing.T) {
+	var (
+		m   = newTestModule(t)
+		out = t.TempDir()
+		g   = &generator{o: &generateOptions{}}
+	)
+
+	checksum := moduleJSChecksum(t, m)
+	require.NoError(t, cacheModule(out, m, checksum))
+
+	cached, err := g.isModuleCached(out, m, moduleJSChecksum(t, m))
+	require.NoError(t, err)
+	require.True(t, cached)
+
+	t.Run("option changed", func(t *testing.T) {
+		cached, err := g.isModuleCached(out, m, moduleJSChecksum(t, m, WithDeclarationsOnly()))
+		require.NoError(t, err)
+		require.False(t, cached, "the module must be regenerated")
+	})
+
+	t.Run("custom template added", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "js_client.tmpl"), []byte("custom"), 0644))
+
+		cached, err := g.isModuleCached(out, m, moduleJSChecksum(t, m, WithCustomTemplates(dir)))
+		require.NoError(t, err)
+		require.False(t, cached, "the module must be regenerated")
+	})
+
+	t.Run("proto file changed", func(t *testing.T) {
+		m := newTestModule(t)
+		require.NoError(t, cacheModule(out, m, moduleJSChecksum(t, m)))
+		require.NoError(t, os.WriteFile(m.Pkg.Files[0].Path, []byte("changed"), 0644))
+
+		cached, err := g.isModuleCached(out, m, moduleJSChecksum(t, m))
+		require.NoError(t, err)
+		require.False(t, cached, "the module must be regenerated")
+	})
+
+	t.Run("force regenerate", func(t *testing.T) {
+		g := &generator{o: &generateOptions{forceRegenerate: true}}
+		cached, err := g.isModuleCached(out, m, checksum)
+		require.NoError(t, err)
+		require.False(t, cached, "the module must be regenerated")
+	})
+}
+
+func TestProtoDirsChecksum(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "cosmos", "foo.proto")
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	require.NoError(t, os.WriteFile(path, []byte("foo"), 0644))
+
+	dirs := []string{dir, filepath.Join(dir, "missing")}
+	checksum, err := protoDirsChecksum(dirs)
+	require.NoError(t, err)
+
+	// non proto files are ignored.
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("readme"), 0644))
+	unchanged, err := protoDirsChecksum(dirs)
+	require.NoError(t, err)
+	require.Equal(t, checksum, unchanged)
+
+	require.NoError(t, os.WriteFile(path, []byte("bar"), 0644))
+	changed, err := protoDirsChecksum(dirs)
+	require.NoError(t, err)
+	require.NotEqual(t, checksum, changed)
+}
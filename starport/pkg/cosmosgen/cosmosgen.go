@@ -2,25 +2,51 @@ package cosmosgen
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"strings"
 
 	"github.com/tendermint/starport/starport/pkg/cosmosanalysis/module"
 	gomodmodule "golang.org/x/mod/module"
+	"golang.org/x/sync/semaphore"
 )
 
+// defaultProtoDir is the default proto dir of the apps, relative to their path.
+const defaultProtoDir = "proto"
+
 // generateOptions used to configure code generation.
 type generateOptions struct {
-	includeDirs []string
-	gomodPath   string
+	protoDir           string
+	includeDirs        []string
+	gomodPath          string
+	forceRegenerate    bool
+	moduleFilter       []string
+	customTemplatesDir string
+	concurrency        int
 
 	jsOut               func(module.Module) string
 	jsIncludeThirdParty bool
 	vuexStoreRootPath   string
+	vuexNamespace       func(module.Module) string
+	reactOut            func(module.Module) string
+	tsDeclarationsOnly  bool
 
-	specOut string
+	specOut        string
+	openAPIVersion int
+	markdownOut    string
 
 	dartOut               func(module.Module) string
 	dartIncludeThirdParty bool
 	dartRootPath          string
+
+	pythonOut    func(module.Module) string
+	pythonBinary string
+
+	rustOut func(module.Module) string
+
+	graphQLOut func(module.Module) string
 }
 
 // TODO add WithInstall.
@@ -50,6 +76,24 @@ func WithVuexGeneration(includeThirdPartyModules bool, out func(module.Module) (
 	}
 }
 
+// WithVuexNamespace sets the key that the module's Vuex store is nested under in the namespaces
+// export of the generated store loader. by default stores are nested under a key derived from
+// the user and repo of the module's source code.
+func WithVuexNamespace(fn func(module.Module) string) Option {
+	return func(o *generateOptions) {
+		o.vuexNamespace = fn
+	}
+}
+
+// WithReactQueryHooks adds React Query hooks generation. out hook is called for each module to
+// retrieve the path that should be used to place generated hooks inside for a given module.
+// hooks wrap the generated JS clients so JS generation needs to be enabled as well.
+func WithReactQueryHooks(out func(module.Module) (path string)) Option {
+	return func(o *generateOptions) {
+		o.reactOut = out
+	}
+}
+
 func WithDartGeneration(includeThirdPartyModules bool, out func(module.Module) (path string), rootPath string) Option {
 	return func(o *generateOptions) {
 		o.dartOut = out
@@ -58,6 +102,39 @@ func WithDartGeneration(includeThirdPartyModules bool, out func(module.Module) (
 	}
 }
 
+// WithPythonClient adds Python client generation. out hook is called for each module to
+// retrieve the path that should be used to place generated Python code inside for a given module.
+// an __init__.py loader is generated in the parent dir of the module paths.
+func WithPythonClient(out func(module.Module) (path string)) Option {
+	return func(o *generateOptions) {
+		o.pythonOut = out
+	}
+}
+
+// WithPythonBinary sets the Python binary used to run the betterproto plugin, python3 is used by default.
+func WithPythonBinary(path string) Option {
+	return func(o *generateOptions) {
+		o.pythonBinary = path
+	}
+}
+
+// WithRustClient adds Rust client generation with prost and tonic. out hook is called for each
+// module to retrieve the path that should be used to place the generated crate for a given module.
+func WithRustClient(out func(module.Module) (path string)) Option {
+	return func(o *generateOptions) {
+		o.rustOut = out
+	}
+}
+
+// WithGraphQLSchema adds GraphQL schema generation. out hook is called for each module to retrieve
+// the path that should be used to place the generated schema and Apollo Server resolvers inside for
+// a given module.
+func WithGraphQLSchema(out func(module.Module) (path string)) Option {
+	return func(o *generateOptions) {
+		o.graphQLOut = out
+	}
+}
+
 // WithGoGeneration adds Go code generation.
 func WithGoGeneration(gomodPath string) Option {
 	return func(o *generateOptions) {
@@ -72,6 +149,74 @@ func WithOpenAPIGeneration(out string) Option {
 	}
 }
 
+// WithMarkdownDocs adds Markdown docs generation. a doc is generated for each module from its
+// OpenAPI spec and placed inside outDir which is relative to the app's path.
+func WithMarkdownDocs(outDir string) Option {
+	return func(o *generateOptions) {
+		o.markdownOut = outDir
+	}
+}
+
+// WithOpenAPIVersion sets the version of the generated OpenAPI spec, version can be 2 or 3.
+// OpenAPI v2 (Swagger 2.0) is generated by default.
+func WithOpenAPIVersion(version int) Option {
+	return func(o *generateOptions) {
+		o.openAPIVersion = version
+	}
+}
+
+// WithForceRegenerate regenerates the code of all modules even if their proto files didn't
+// change since the last generation.
+func WithForceRegenerate() Option {
+	return func(o *generateOptions) {
+		o.forceRegenerate = true
+	}
+}
+
+// WithDeclarationsOnly only emits the TypeScript declarations of the generated JS code,
+// the TS files are type checked without being compiled to JS.
+func WithDeclarationsOnly() Option {
+	return func(o *generateOptions) {
+		o.tsDeclarationsOnly = true
+	}
+}
+
+// WithModuleFilter restricts code generation to the modules whose proto package path matches one of
+// the moduleNames. package paths are the proto package names separated by slashes and patterns are
+// matched with path.Match, e.g. cosmos/bank/* matches the cosmos.bank.v1beta1 package.
+func WithModuleFilter(moduleNames ...string) Option {
+	return func(o *generateOptions) {
+		o.moduleFilter = moduleNames
+	}
+}
+
+// WithCustomTemplates uses the Go templates inside dir instead of the built-in ones. templates
+// are named after the code they generate with the .tmpl extension: js_client, vuex_store,
+// vuex_root, react_hooks, markdown, graphql_schema, python_loader, rust_crate and rust_lib.
+// built-in templates are used for the ones that don't exist in dir, custom templates receive
+// the same data as the built-in ones.
+func WithCustomTemplates(dir string) Option {
+	return func(o *generateOptions) {
+		o.customTemplatesDir = dir
+	}
+}
+
+// WithConcurrency limits the number of modules that code is generated for simultaneously to n,
+// which also limits the number of simultaneous protoc invocations. runtime.NumCPU() is used by default.
+func WithConcurrency(n int) Option {
+	return func(o *generateOptions) {
+		o.concurrency = n
+	}
+}
+
+// WithProtoDir overrides the proto directory passed to Generate, dir can be either absolute
+// or relative to the app path but it must be inside the app.
+func WithProtoDir(dir string) Option {
+	return func(o *generateOptions) {
+		o.protoDir = dir
+	}
+}
+
 // IncludeDirs configures the third party proto dirs that used by app's proto.
 // relative to the projectPath.
 func IncludeDirs(dirs []string) Option {
@@ -90,16 +235,69 @@ type generator struct {
 	deps         []gomodmodule.Version
 	appModules   []module.Module
 	thirdModules map[string][]module.Module // app dependency-modules pair.
+	sem          *semaphore.Weighted        // limits simultaneous protoc invocations.
+
+	// skipVuexLoader skips the generation of the Vuex module loader, it is generated
+	// separately when the code of multiple apps is generated.
+	skipVuexLoader bool
 }
 
 // Generate generates code from protoDir of an SDK app residing at appPath with given options.
 // protoDir must be relative to the projectPath.
 func Generate(ctx context.Context, appPath, protoDir string, options ...Option) error {
+	g, err := newGenerator(ctx, appPath, protoDir, options...)
+	if err != nil {
+		return err
+	}
+	return g.generate()
+}
+
+// GenerateMulti generates code for each of the SDK apps residing at appPaths with given options, it is
+// used for the monorepos hosting multiple apps. the proto dir of the apps is the default one unless it is
+// set with WithProtoDir. a single Vuex module loader is generated for the stores of all the apps, the stores
+// of different apps having the same name are prefixed with the repo name of their app.
+func GenerateMulti(ctx context.Context, appPaths []string, options ...Option) error {
+	if len(appPaths) == 0 {
+		return errors.New("no app to generate code for")
+	}
+
+	var (
+		g    *generator
+		apps []vuexApp
+	)
+	for _, appPath := range appPaths {
+		var err error
+		if g, err = newGenerator(ctx, appPath, defaultProtoDir, options...); err != nil {
+			return err
+		}
+		g.skipVuexLoader = true
+
+		if err := g.generate(); err != nil {
+			return err
+		}
+
+		if g.o.jsOut != nil {
+			app, err := newJSGenerator(g).vuexApp()
+			if err != nil {
+				return err
+			}
+			apps = append(apps, app)
+		}
+	}
+
+	if len(apps) == 0 {
+		return nil
+	}
+	return writeVuexModuleLoader(g, apps)
+}
+
+// newGenerator creates a generator for the app residing at appPath with given options.
+func newGenerator(ctx context.Context, appPath, protoDir string, options ...Option) (*generator, error) {
 	g := &generator{
 		ctx:          ctx,
 		appPath:      appPath,
 		protoDir:     protoDir,
-		o:            &generateOptions{},
+		o:            &generateOptions{openAPIVersion: openAPIV2, concurrency: runtime.NumCPU()},
 		thirdModules: make(map[string][]module.Module),
 	}
 
@@ -107,6 +305,27 @@ func Generate(ctx context.Context, appPath, protoDir string, options ...Option)
 		apply(g.o)
 	}
 
+	if g.o.protoDir != "" {
+		var err error
+		if g.protoDir, err = resolveProtoDir(appPath, g.o.protoDir); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := validateModuleFilter(g.o.moduleFilter); err != nil {
+		return nil, err
+	}
+
+	if g.o.concurrency < 1 {
+		return nil, fmt.Errorf("concurrency must be at least 1, got %d", g.o.concurrency)
+	}
+	g.sem = semaphore.NewWeighted(int64(g.o.concurrency))
+
+	return g, nil
+}
+
+// generate generates the code of the app with the options of the generator.
+func (g *generator) generate() error {
 	if err := g.setup(); err != nil {
 		return err
 	}
@@ -126,13 +345,37 @@ func Generate(ctx context.Context, appPath, protoDir string, options ...Option)
 		}
 	}
 
+	if g.o.reactOut != nil {
+		if err := g.generateReactHooks(); err != nil {
+			return err
+		}
+	}
+
 	if g.o.dartOut != nil {
 		if err := g.generateDart(); err != nil {
 			return err
 		}
 	}
 
-	if g.o.specOut != "" {
+	if g.o.pythonOut != nil {
+		if err := g.generatePython(); err != nil {
+			return err
+		}
+	}
+
+	if g.o.rustOut != nil {
+		if err := g.generateRust(); err != nil {
+			return err
+		}
+	}
+
+	if g.o.graphQLOut != nil {
+		if err := g.generateGraphQL(); err != nil {
+			return err
+		}
+	}
+
+	if g.o.specOut != "" || g.o.markdownOut != "" {
 		if err := generateOpenAPISpec(g); err != nil {
 			return err
 		}
@@ -141,3 +384,23 @@ func Generate(ctx context.Context, appPath, protoDir string, options ...Option)
 	return nil
 
 }
+
+// resolveProtoDir returns the path of the proto dir relative to the app path.
+func resolveProtoDir(appPath, protoDir string) (string, error) {
+	if !filepath.IsAbs(protoDir) {
+		return filepath.Clean(protoDir), nil
+	}
+
+	absAppPath, err := filepath.Abs(appPath)
+	if err != nil {
+		return "", err
+	}
+	rel, err := filepath.Rel(absAppPath, protoDir)
+	if err != nil {
+		return "", err
+	}
+	if rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("proto dir %s is not inside the app %s", protoDir, absAppPath)
+	}
+	return rel, nil
+}
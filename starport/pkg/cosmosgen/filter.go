@@ -0,0 +1,36 @@
+package cosmosgen
+
+import (
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/tendermint/starport/starport/pkg/cosmosanalysis/module"
+)
+
+// validateModuleFilter makes sure that all module filter patterns are well formed.
+func validateModuleFilter(patterns []string) error {
+	for _, pattern := range patterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return errors.Wrapf(err, "invalid module filter %q", pattern)
+		}
+	}
+	return nil
+}
+
+// isModuleIncluded checks if code should be generated for the module.
+// all modules are included when there is no module filter, otherwise the module's proto package
+// path (e.g. cosmos/bank/v1beta1 for cosmos.bank.v1beta1) must match one of the filter patterns.
+func (g *generator) isModuleIncluded(m module.Module) bool {
+	if len(g.o.moduleFilter) == 0 {
+		return true
+	}
+
+	pkgPath := strings.ReplaceAll(m.Pkg.Name, ".", "/")
+	for _, pattern := range g.o.moduleFilter {
+		if ok, _ := path.Match(pattern, pkgPath); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/tendermint/starport/starport/pkg/protoc"
 	protocgendart "github.com/tendermint/starport/starport/pkg/protoc-gen-dart"
 	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/semaphore"
 )
 
 var (
@@ -52,7 +53,7 @@ func (g *dartGenerator) generateModules() error {
 	add := func(sourcePath string, modules []module.Module) {
 		for _, m := range modules {
 			m := m
-			gg.Go(func() error { return g.generateModule(g.g.ctx, flag, sourcePath, m) })
+			gg.Go(func() error { return g.generateModule(g.g.ctx, g.g.sem, flag, sourcePath, m) })
 		}
 	}
 
@@ -67,7 +68,17 @@ func (g *dartGenerator) generateModules() error {
 	return gg.Wait()
 }
 
-func (g *dartGenerator) generateModule(ctx context.Context, plugin, appPath string, m module.Module) error {
+func (g *dartGenerator) generateModule(ctx context.Context, sem *semaphore.Weighted, plugin, appPath string, m module.Module) error {
+	if !g.g.isModuleIncluded(m) {
+		return nil
+	}
+
+	// limit the number of simultaneous protoc invocations.
+	if err := sem.Acquire(ctx, 1); err != nil {
+		return err
+	}
+	defer sem.Release(1)
+
 	var (
 		out       = g.g.o.dartOut(m)
 		clientOut = filepath.Join(out, dartClientDirName)
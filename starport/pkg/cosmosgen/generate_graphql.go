@@ -0,0 +1,243 @@
+package cosmosgen
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"github.com/tendermint/starport/starport/pkg/cosmosanalysis/module"
+	"github.com/tendermint/starport/starport/pkg/protoc"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/semaphore"
+)
+
+const graphQLJSONScalar = "JSON"
+
+var graphQLInvalidNameChars = regexp.MustCompile(`[^_0-9A-Za-z]`)
+
+// graphQLSchema holds the data used to generate a GraphQL schema and its resolvers.
+type graphQLSchema struct {
+	Module    module.Module
+	Types     []graphQLType
+	Queries   []graphQLOperation
+	Mutations []graphQLOperation
+}
+
+type graphQLType struct {
+	Name   string
+	Fields []graphQLField
+}
+
+type graphQLField struct {
+	Name string
+	Type string
+}
+
+type graphQLOperation struct {
+	Name       string
+	ReturnType string
+	Args       []graphQLArg
+	QueryArgs  []graphQLArg
+	Method     string
+	Path       string
+	HasBody    bool
+}
+
+type graphQLArg struct {
+	Name     string
+	Original string
+	Type     string
+}
+
+type graphQLGenerator struct {
+	g *generator
+}
+
+func newGraphQLGenerator(g *generator) *graphQLGenerator {
+	return &graphQLGenerator{
+		g: g,
+	}
+}
+
+func (g *generator) generateGraphQL() error {
+	return newGraphQLGenerator(g).generateModules()
+}
+
+func (g *graphQLGenerator) generateModules() error {
+	gg := &errgroup.Group{}
+
+	for _, m := range g.g.appModules {
+		m := m
+		gg.Go(func() error { return g.generateModule(g.g.ctx, g.g.sem, g.g.appPath, m) })
+	}
+
+	return gg.Wait()
+}
+
+// generateModule generates a GraphQL schema and resolvers for a module from its OpenAPI spec.
+func (g *graphQLGenerator) generateModule(ctx context.Context, sem *semaphore.Weighted, appPath string, m module.Module) error {
+	if !g.g.isModuleIncluded(m) {
+		return nil
+	}
+
+	// limit the number of simultaneous protoc invocations.
+	if err := sem.Acquire(ctx, 1); err != nil {
+		return err
+	}
+	defer sem.Release(1)
+
+	out := g.g.o.graphQLOut(m)
+
+	includePaths, err := g.g.resolveInclude(appPath)
+	if err != nil {
+		return err
+	}
+
+	// generate OpenAPI spec to use as the intermediate representation.
+	oaitemp, err := os.MkdirTemp("", "gen-graphql-openapi-module-spec")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(oaitemp)
+
+	if err := protoc.Generate(
+		ctx,
+		oaitemp,
+		m.Pkg.Path,
+		includePaths,
+		jsOpenAPIOut,
+	); err != nil {
+		return err
+	}
+
+	content, err := os.ReadFile(filepath.Join(oaitemp, "apidocs.swagger.json"))
+	if err != nil {
+		return err
+	}
+
+	var doc openAPIDoc
+	if err := json.Unmarshal(content, &doc); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(out, 0766); err != nil {
+		return err
+	}
+
+	return g.g.writeTemplate(templateGraphQL, out, "", newGraphQLSchema(m, doc))
+}
+
+// newGraphQLSchema maps the definitions of the OpenAPI spec to GraphQL types and
+// its operations to Query and Mutation fields.
+func newGraphQLSchema(m module.Module, doc openAPIDoc) graphQLSchema {
+	schema := graphQLSchema{Module: m}
+
+	for name, definition := range doc.Definitions {
+		t := graphQLType{Name: graphQLName(name)}
+		for fieldName, field := range definition.Properties {
+			t.Fields = append(t.Fields, graphQLField{
+				Name: graphQLName(fieldName),
+				Type: graphQLTypeName(field),
+			})
+		}
+		sort.Slice(t.Fields, func(i, j int) bool { return t.Fields[i].Name < t.Fields[j].Name })
+
+		// GraphQL types cannot be empty.
+		if len(t.Fields) == 0 {
+			t.Fields = []graphQLField{{Name: "_empty", Type: "Boolean"}}
+		}
+		schema.Types = append(schema.Types, t)
+	}
+	sort.Slice(schema.Types, func(i, j int) bool { return schema.Types[i].Name < schema.Types[j].Name })
+
+	for path, methods := range doc.Paths {
+		for method, op := range methods {
+			operation := graphQLOperation{
+				Name:       strcase.ToLowerCamel(graphQLName(op.OperationID)),
+				ReturnType: graphQLJSONScalar,
+				Method:     strings.ToUpper(method),
+				Path:       path,
+			}
+
+			if response, ok := op.Responses["200"]; ok && response.Schema != nil {
+				operation.ReturnType = graphQLTypeName(*response.Schema)
+			}
+
+			for _, param := range op.Parameters {
+				if param.In == "body" {
+					operation.HasBody = true
+					operation.Args = append(operation.Args, graphQLArg{
+						Name:     "body",
+						Original: param.Name,
+						Type:     graphQLJSONScalar,
+					})
+					continue
+				}
+
+				arg := graphQLArg{
+					Name:     graphQLName(param.Name),
+					Original: param.Name,
+					Type:     graphQLTypeName(openAPIDocSchema{Type: param.Type, Format: param.Format}),
+				}
+				if param.Required {
+					arg.Type += "!"
+				}
+				operation.Args = append(operation.Args, arg)
+
+				switch param.In {
+				case "path":
+					operation.Path = strings.ReplaceAll(operation.Path, "{"+param.Name+"}", "${args."+arg.Name+"}")
+				case "query":
+					operation.QueryArgs = append(operation.QueryArgs, arg)
+				}
+			}
+
+			if operation.Method == "GET" {
+				schema.Queries = append(schema.Queries, operation)
+			} else {
+				schema.Mutations = append(schema.Mutations, operation)
+			}
+		}
+	}
+	sort.Slice(schema.Queries, func(i, j int) bool { return schema.Queries[i].Name < schema.Queries[j].Name })
+	sort.Slice(schema.Mutations, func(i, j int) bool { return schema.Mutations[i].Name < schema.Mutations[j].Name })
+
+	return schema
+}
+
+// graphQLTypeName returns the GraphQL type for an OpenAPI schema.
+// 64 bit integers are encoded as strings by the REST API, so they are kept as strings.
+func graphQLTypeName(s openAPIDocSchema) string {
+	switch {
+	case s.Ref != "":
+		return graphQLName(refName(s.Ref))
+	case s.Type == "array" && s.Items != nil:
+		return "[" + graphQLTypeName(*s.Items) + "]"
+	}
+
+	switch s.Type {
+	case "string":
+		return "String"
+	case "boolean":
+		return "Boolean"
+	case "integer":
+		return "Int"
+	case "number":
+		return "Float"
+	}
+	return graphQLJSONScalar
+}
+
+// graphQLName converts name to a valid GraphQL name.
+func graphQLName(name string) string {
+	name = graphQLInvalidNameChars.ReplaceAllString(name, "_")
+	if name != "" && name[0] >= '0' && name[0] <= '9' {
+		name = "_" + name
+	}
+	return name
+}
@@ -0,0 +1,30 @@
+package cosmosgen
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateGraphQLSchema(t *testing.T) {
+	var doc openAPIDoc
+	require.NoError(t, json.Unmarshal([]byte(testOpenAPIDoc), &doc))
+
+	g, err := newGenerator(context.Background(), t.TempDir(), defaultProtoDir)
+	require.NoError(t, err)
+
+	out := t.TempDir()
+	require.NoError(t, g.writeTemplate(templateGraphQL, out, "", newGraphQLSchema(testDocModule, doc)))
+
+	for _, name := range []string{"schema.graphql", "resolvers.ts"} {
+		want, err := os.ReadFile(filepath.Join("testdata", "graphql", name))
+		require.NoError(t, err)
+		got, err := os.ReadFile(filepath.Join(out, name))
+		require.NoError(t, err)
+		require.Equal(t, string(want), string(got), name)
+	}
+}
@@ -2,10 +2,13 @@ package cosmosgen
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 
+	"github.com/go-git/go-git/v5"
 	"github.com/iancoleman/strcase"
 	"github.com/tendermint/starport/starport/pkg/cosmosanalysis/module"
 	"github.com/tendermint/starport/starport/pkg/giturl"
@@ -17,6 +20,7 @@ import (
 	"github.com/tendermint/starport/starport/pkg/protoc"
 	"github.com/tendermint/starport/starport/pkg/xstrings"
 	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/semaphore"
 )
 
 var (
@@ -48,52 +52,125 @@ func (g *generator) generateJS() error {
 		return err
 	}
 
-	if err := jsg.generateVuexModuleLoader(); err != nil {
-		return err
+	// the loader of apps generated together is written once their stores are all generated.
+	if g.skipVuexLoader {
+		return nil
 	}
 
-	return nil
+	return jsg.generateVuexModuleLoader()
 }
 
 func (g *jsGenerator) generateModules() error {
-	tsprotoPluginPath, cleanup, err := tsproto.BinaryPath()
+	tsprotoPluginPath, cleanup, err := tsproto.BinaryPathCached()
 	if err != nil {
 		return err
 	}
 	defer cleanup()
 
+	inputs, err := g.generationInputs()
+	if err != nil {
+		return err
+	}
+
+	sources := map[string][]module.Module{g.g.appPath: g.g.appModules}
+	if g.g.o.jsIncludeThirdParty {
+		for sourcePath, modules := range g.g.thirdModules {
+			sources[sourcePath] = append(sources[sourcePath], modules...)
+		}
+	}
+
 	gg := &errgroup.Group{}
 
-	add := func(sourcePath string, modules []module.Module) {
+	for sourcePath, modules := range sources {
+		includePaths, err := g.g.resolveInclude(sourcePath)
+		if err != nil {
+			gg.Wait()
+			return err
+		}
+
+		// the generated code also depends on the proto files imported by the modules.
+		includeChecksum, err := protoDirsChecksum(includePaths)
+		if err != nil {
+			gg.Wait()
+			return err
+		}
+		moduleInputs := append([]string{"include=" + includeChecksum}, inputs...)
+
 		for _, m := range modules {
-			m := m
-			gg.Go(func() error { return g.generateModule(g.g.ctx, tsprotoPluginPath, sourcePath, m) })
+			sourcePath, m := sourcePath, m
+			gg.Go(func() error {
+				return g.generateModule(g.g.ctx, g.g.sem, tsprotoPluginPath, sourcePath, includePaths, moduleInputs, m)
+			})
 		}
 	}
 
-	add(g.g.appPath, g.g.appModules)
+	return gg.Wait()
+}
 
-	if g.g.o.jsIncludeThirdParty {
-		for sourcePath, modules := range g.g.thirdModules {
-			add(sourcePath, modules)
+// generationInputs returns the inputs of the JS code generation besides the proto files, the generated
+// code of the modules is outdated when one of them changes.
+func (g *jsGenerator) generationInputs() ([]string, error) {
+	inputs := []string{
+		"tools=" + toolsChecksum(),
+		"protoDir=" + g.g.protoDir,
+		"includeDirs=" + strings.Join(g.g.o.includeDirs, ","),
+		fmt.Sprintf("vuex=%t", g.g.o.vuexStoreRootPath != ""),
+		fmt.Sprintf("declarationsOnly=%t", g.g.o.tsDeclarationsOnly),
+	}
+
+	templates := []templateWriter{templateJSClient}
+	if g.g.o.vuexStoreRootPath != "" {
+		templates = append(templates, templateVuexStore)
+	}
+	for _, t := range templates {
+		checksum, err := t.checksum(g.g.o.customTemplatesDir)
+		if err != nil {
+			return nil, err
 		}
+		inputs = append(inputs, t.customName+"="+checksum)
 	}
 
-	return gg.Wait()
+	return inputs, nil
 }
 
 // generateModule generates generates JS code for a module.
-func (g *jsGenerator) generateModule(ctx context.Context, tsprotoPluginPath, appPath string, m module.Module) error {
+func (g *jsGenerator) generateModule(
+	ctx context.Context,
+	sem *semaphore.Weighted,
+	tsprotoPluginPath,
+	appPath string,
+	includePaths,
+	inputs []string,
+	m module.Module,
+) error {
+	if !g.g.isModuleIncluded(m) {
+		return nil
+	}
+
+	// limit the number of simultaneous protoc invocations.
+	if err := sem.Acquire(ctx, 1); err != nil {
+		return err
+	}
+	defer sem.Release(1)
+
 	var (
 		out          = g.g.o.jsOut(m)
 		storeDirPath = filepath.Dir(out)
 		typesOut     = filepath.Join(out, "types")
 	)
 
-	includePaths, err := g.g.resolveInclude(appPath)
+	// skip generation when the module's proto files and the generation inputs didn't change since the last generation.
+	checksum, err := moduleChecksum(m, inputs...)
+	if err != nil {
+		return err
+	}
+	cached, err := g.g.isModuleCached(out, m, checksum)
 	if err != nil {
 		return err
 	}
+	if cached {
+		return nil
+	}
 
 	if err := os.MkdirAll(typesOut, 0766); err != nil {
 		return err
@@ -142,33 +219,107 @@ func (g *jsGenerator) generateModule(ctx context.Context, tsprotoPluginPath, app
 
 	// generate the js client wrapper.
 	pp := filepath.Join(appPath, g.g.protoDir)
-	if err := templateJSClient.Write(out, pp, struct{ Module module.Module }{m}); err != nil {
+	if err := g.g.writeTemplate(templateJSClient, out, pp, struct{ Module module.Module }{m}); err != nil {
 		return err
 	}
 
 	// generate Vuex if enabled.
 	if g.g.o.vuexStoreRootPath != "" {
-		err = templateVuexStore.Write(storeDirPath, pp, struct{ Module module.Module }{m})
+		err = g.g.writeTemplate(templateVuexStore, storeDirPath, pp, struct{ Module module.Module }{m})
 		if err != nil {
 			return err
 		}
 	}
 	// generate .js and .d.ts files for all ts files.
-	return tsc.Generate(g.g.ctx, tscConfig(storeDirPath+"/**/*.ts"))
+	if err := tsc.Generate(g.g.ctx, tscConfig(g.g.o.tsDeclarationsOnly, storeDirPath+"/**/*.ts")); err != nil {
+		return err
+	}
+
+	return cacheModule(out, m, checksum)
 }
 
 func (g *jsGenerator) generateVuexModuleLoader() error {
-	modulePaths, err := localfs.Search(g.g.o.vuexStoreRootPath, vuexRootMarker)
+	app, err := g.vuexApp()
 	if err != nil {
 		return err
 	}
+	return writeVuexModuleLoader(g.g, []vuexApp{app})
+}
+
+// vuexApp holds the source of an app and the modules its generated stores belong to.
+type vuexApp struct {
+	url giturl.GitURL
 
+	// storeModules are the modules of the app indexed by their store dir relative to the store root.
+	storeModules map[string]module.Module
+}
+
+// vuexApp returns the source and store modules of the app of the generator.
+func (g *jsGenerator) vuexApp() (vuexApp, error) {
 	chainPath, _, err := gomodulepath.Find(g.g.appPath)
 	if err != nil {
-		return err
+		return vuexApp{}, err
 	}
 
 	chainURL, err := giturl.Parse(chainPath.RawPath)
+	if err != nil {
+		// the module path of the app is not a repo url, use the origin remote of its repo instead
+		if chainURL, err = originGitURL(g.g.appPath); err != nil {
+			return vuexApp{}, err
+		}
+	}
+
+	// find out the modules that generated stores belong to so namespaces can be set per module.
+	storeModules := make(map[string]module.Module)
+	addStoreModules := func(modules []module.Module) error {
+		for _, m := range modules {
+			storeDirPath, err := filepath.Rel(g.g.o.vuexStoreRootPath, filepath.Dir(g.g.o.jsOut(m)))
+			if err != nil {
+				return err
+			}
+			storeModules[storeDirPath] = m
+		}
+		return nil
+	}
+	if err := addStoreModules(g.g.appModules); err != nil {
+		return vuexApp{}, err
+	}
+	for _, modules := range g.g.thirdModules {
+		if err := addStoreModules(modules); err != nil {
+			return vuexApp{}, err
+		}
+	}
+
+	return vuexApp{url: chainURL, storeModules: storeModules}, nil
+}
+
+// originGitURL returns the url of the origin remote of the Git repo containing path,
+// the ssh remotes commonly used by contributors locally are supported.
+func originGitURL(path string) (giturl.GitURL, error) {
+	repo, err := git.PlainOpenWithOptions(path, &git.PlainOpenOptions{DetectDotGit: true})
+	if err != nil {
+		return giturl.GitURL{}, err
+	}
+	remote, err := repo.Remote(git.DefaultRemoteName)
+	if err != nil {
+		return giturl.GitURL{}, err
+	}
+	urls := remote.Config().URLs
+	if len(urls) == 0 {
+		return giturl.GitURL{}, fmt.Errorf("remote %s of %s has no url", git.DefaultRemoteName, path)
+	}
+
+	if u, err := giturl.Parse(urls[0]); err == nil {
+		return u, nil
+	}
+	return giturl.ParseSSH(urls[0])
+}
+
+// writeVuexModuleLoader writes the loader of the stores found in the store root of g, the stores
+// belong to apps. the first app is used for the stores that don't belong to any of the apps.
+// when stores of different apps have the same name, their names are prefixed with their repo name.
+func writeVuexModuleLoader(g *generator, apps []vuexApp) error {
+	modulePaths, err := localfs.Search(g.o.vuexStoreRootPath, vuexRootMarker)
 	if err != nil {
 		return err
 	}
@@ -180,17 +331,33 @@ func (g *jsGenerator) generateVuexModuleLoader() error {
 		FullPath string
 	}
 
-	data := struct {
+	type namespace struct {
+		Name    string
 		Modules []module
-		User    string
-		Repo    string
+	}
+
+	data := struct {
+		Modules    []module
+		Namespaces []namespace
+		User       string
+		Repo       string
 	}{
-		User: chainURL.User,
-		Repo: chainURL.Repo,
+		User: apps[0].url.User,
+		Repo: apps[0].url.Repo,
+	}
+
+	type storeModule struct {
+		module
+		app int
 	}
 
+	// the stores are attributed to the first app they belong to.
+	var (
+		stores    []storeModule
+		pathsApps = make(map[string]map[int]bool)
+	)
 	for _, path := range modulePaths {
-		pathrel, err := filepath.Rel(g.g.o.vuexStoreRootPath, path)
+		pathrel, err := filepath.Rel(g.o.vuexStoreRootPath, path)
 		if err != nil {
 			return err
 		}
@@ -201,28 +368,84 @@ func (g *jsGenerator) generateVuexModuleLoader() error {
 			path     = filepath.Base(fullPath)
 			name     = strcase.ToCamel(path)
 		)
-		data.Modules = append(data.Modules, module{
-			Name:     name,
-			Path:     path,
-			FullName: fullName,
-			FullPath: fullPath,
+		store := storeModule{
+			module: module{
+				Name:     name,
+				Path:     path,
+				FullName: fullName,
+				FullPath: fullPath,
+			},
+			app: -1,
+		}
+		for i, app := range apps {
+			if _, ok := app.storeModules[fullPath]; ok {
+				store.app = i
+				break
+			}
+		}
+		stores = append(stores, store)
+
+		if store.app < 0 {
+			continue
+		}
+		if pathsApps[path] == nil {
+			pathsApps[path] = make(map[int]bool)
+		}
+		pathsApps[path][store.app] = true
+	}
+
+	namespaces := make(map[string][]module)
+
+	for _, store := range stores {
+		app := apps[0]
+		if store.app >= 0 {
+			app = apps[store.app]
+		}
+
+		m := store.module
+		if len(pathsApps[m.Path]) > 1 {
+			m.Path = app.url.Repo + "-" + m.Path
+			m.Name = strcase.ToCamel(m.Path)
+		}
+		data.Modules = append(data.Modules, m)
+
+		// stores are nested under the user and repo of the module's source code by default.
+		var ns string
+		if sm, ok := app.storeModules[m.FullPath]; ok && g.o.vuexNamespace != nil {
+			ns = g.o.vuexNamespace(sm)
+		} else {
+			user, repo := app.url.User, app.url.Repo
+			if parts := strings.Split(m.FullPath, "/"); len(parts) > 2 {
+				user, repo = parts[0], parts[1]
+			}
+			ns = xstrings.FormatUsername(strcase.ToCamel(user + "_" + repo))
+		}
+		namespaces[ns] = append(namespaces[ns], m)
+	}
+
+	for name, modules := range namespaces {
+		data.Namespaces = append(data.Namespaces, namespace{
+			Name:    name,
+			Modules: modules,
 		})
 	}
+	sort.Slice(data.Namespaces, func(i, j int) bool { return data.Namespaces[i].Name < data.Namespaces[j].Name })
 
-	loaderPath := filepath.Join(g.g.o.vuexStoreRootPath, "index.ts")
+	loaderPath := filepath.Join(g.o.vuexStoreRootPath, "index.ts")
 
-	if err := templateVuexRoot.Write(g.g.o.vuexStoreRootPath, "", data); err != nil {
+	if err := g.writeTemplate(templateVuexRoot, g.o.vuexStoreRootPath, "", data); err != nil {
 		return err
 	}
 
-	return tsc.Generate(g.g.ctx, tscConfig(loaderPath))
+	return tsc.Generate(g.ctx, tscConfig(g.o.tsDeclarationsOnly, loaderPath))
 }
 
-func tscConfig(include ...string) tsc.Config {
+func tscConfig(declarationsOnly bool, include ...string) tsc.Config {
 	return tsc.Config{
 		Include: include,
 		CompilerOptions: tsc.CompilerOptions{
-			Declaration: true,
+			Declaration:          true,
+			EmitDeclarationsOnly: declarationsOnly,
 		},
 	}
 }
@@ -0,0 +1,62 @@
+package cosmosgen
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/pkg/giturl"
+)
+
+func TestOriginGitURL(t *testing.T) {
+	tests := []struct {
+		name   string
+		remote string
+		want   giturl.GitURL
+		err    bool
+	}{
+		{
+			name:   "https remote",
+			remote: "https://github.com/tendermint/planet",
+			want:   giturl.GitURL{Host: "github.com", User: "tendermint", Repo: "planet"},
+		},
+		{
+			name:   "ssh remote",
+			remote: "git@github.com:tendermint/planet.git",
+			want:   giturl.GitURL{Host: "github.com", User: "tendermint", Repo: "planet"},
+		},
+		{
+			name: "no remote",
+			err:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repoPath := t.TempDir()
+			repo, err := git.PlainInit(repoPath, false)
+			require.NoError(t, err)
+			if tt.remote != "" {
+				_, err = repo.CreateRemote(&config.RemoteConfig{
+					Name: git.DefaultRemoteName,
+					URLs: []string{tt.remote},
+				})
+				require.NoError(t, err)
+			}
+
+			// the app can be in a subdirectory of the repo.
+			appPath := filepath.Join(repoPath, "planet")
+			require.NoError(t, os.Mkdir(appPath, 0755))
+
+			got, err := originGitURL(appPath)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
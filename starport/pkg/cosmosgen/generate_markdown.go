@@ -0,0 +1,208 @@
+package cosmosgen
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/tendermint/starport/starport/pkg/cosmosanalysis/module"
+)
+
+const (
+	markdownTemplateFileName = "module.md"
+	markdownFileExt          = ".md"
+)
+
+// openAPIDoc is the subset of an OpenAPI v2 spec used to generate Markdown docs.
+type openAPIDoc struct {
+	Paths       map[string]map[string]openAPIDocOperation `json:"paths"`
+	Definitions map[string]openAPIDocSchema               `json:"definitions"`
+}
+
+type openAPIDocOperation struct {
+	OperationID string                        `json:"operationId"`
+	Summary     string                        `json:"summary"`
+	Description string                        `json:"description"`
+	Parameters  []openAPIDocParameter         `json:"parameters"`
+	Responses   map[string]openAPIDocResponse `json:"responses"`
+}
+
+type openAPIDocParameter struct {
+	Name        string            `json:"name"`
+	In          string            `json:"in"`
+	Description string            `json:"description"`
+	Required    bool              `json:"required"`
+	Type        string            `json:"type"`
+	Format      string            `json:"format"`
+	Schema      *openAPIDocSchema `json:"schema"`
+}
+
+type openAPIDocResponse struct {
+	Description string            `json:"description"`
+	Schema      *openAPIDocSchema `json:"schema"`
+}
+
+type openAPIDocSchema struct {
+	Ref         string                      `json:"$ref"`
+	Type        string                      `json:"type"`
+	Format      string                      `json:"format"`
+	Title       string                      `json:"title"`
+	Description string                      `json:"description"`
+	Items       *openAPIDocSchema           `json:"items"`
+	Properties  map[string]openAPIDocSchema `json:"properties"`
+}
+
+// markdownOperation holds the docs of an HTTP operation.
+type markdownOperation struct {
+	Name        string
+	Method      string
+	Path        string
+	Description string
+	PathParams  []markdownField
+	QueryParams []markdownField
+	Request     *markdownSchema
+	Response    *markdownSchema
+}
+
+// markdownSchema holds the docs of a request or response type.
+type markdownSchema struct {
+	Name   string
+	Fields []markdownField
+}
+
+// markdownField holds the docs of a parameter or a type field.
+type markdownField struct {
+	Name        string
+	Type        string
+	Required    bool
+	Description string
+}
+
+// generateMarkdownDoc generates a Markdown doc for the module from its OpenAPI spec at specPath.
+func (g *generator) generateMarkdownDoc(specPath string, m module.Module) error {
+	out := filepath.Join(g.appPath, g.o.markdownOut)
+
+	content, err := os.ReadFile(specPath)
+	if err != nil {
+		return err
+	}
+
+	var doc openAPIDoc
+	if err := json.Unmarshal(content, &doc); err != nil {
+		return err
+	}
+
+	data := struct {
+		Module     module.Module
+		Operations []markdownOperation
+	}{
+		Module:     m,
+		Operations: doc.operations(),
+	}
+
+	if err := os.MkdirAll(out, 0766); err != nil {
+		return err
+	}
+	if err := g.writeTemplate(templateMarkdown, out, "", data); err != nil {
+		return err
+	}
+
+	// the template writer names files after their templates, rename the doc after the module.
+	return os.Rename(
+		filepath.Join(out, markdownTemplateFileName),
+		filepath.Join(out, m.Pkg.Name+markdownFileExt),
+	)
+}
+
+// operations returns the docs of all operations in the spec sorted by name.
+func (d openAPIDoc) operations() (operations []markdownOperation) {
+	for path, methods := range d.Paths {
+		for method, op := range methods {
+			operation := markdownOperation{
+				Name:        op.OperationID,
+				Method:      strings.ToUpper(method),
+				Path:        path,
+				Description: strings.TrimSpace(strings.Join([]string{op.Summary, op.Description}, "\n\n")),
+			}
+
+			for _, param := range op.Parameters {
+				field := markdownField{
+					Name:        param.Name,
+					Type:        schemaTypeName(openAPIDocSchema{Type: param.Type, Format: param.Format}),
+					Required:    param.Required,
+					Description: markdownCell(param.Description),
+				}
+
+				switch param.In {
+				case "path":
+					operation.PathParams = append(operation.PathParams, field)
+				case "query":
+					operation.QueryParams = append(operation.QueryParams, field)
+				case "body":
+					if param.Schema != nil {
+						operation.Request = d.schema(*param.Schema)
+					}
+				}
+			}
+
+			if response, ok := op.Responses["200"]; ok && response.Schema != nil {
+				operation.Response = d.schema(*response.Schema)
+			}
+
+			operations = append(operations, operation)
+		}
+	}
+
+	sort.Slice(operations, func(i, j int) bool { return operations[i].Name < operations[j].Name })
+	return operations
+}
+
+// schema returns the docs of a schema by resolving its definition.
+func (d openAPIDoc) schema(s openAPIDocSchema) *markdownSchema {
+	name := schemaTypeName(s)
+	if s.Ref != "" {
+		s = d.Definitions[refName(s.Ref)]
+	}
+
+	schema := &markdownSchema{Name: name}
+	for fieldName, field := range s.Properties {
+		description := field.Description
+		if description == "" {
+			description = field.Title
+		}
+		schema.Fields = append(schema.Fields, markdownField{
+			Name:        fieldName,
+			Type:        schemaTypeName(field),
+			Description: markdownCell(description),
+		})
+	}
+	sort.Slice(schema.Fields, func(i, j int) bool { return schema.Fields[i].Name < schema.Fields[j].Name })
+
+	return schema
+}
+
+// schemaTypeName returns a human readable type name for the schema.
+func schemaTypeName(s openAPIDocSchema) string {
+	switch {
+	case s.Ref != "":
+		return refName(s.Ref)
+	case s.Type == "array" && s.Items != nil:
+		return schemaTypeName(*s.Items) + "[]"
+	case s.Format != "":
+		return s.Type + " (" + s.Format + ")"
+	}
+	return s.Type
+}
+
+// refName returns the definition name of a schema reference.
+func refName(ref string) string {
+	return ref[strings.LastIndex(ref, "/")+1:]
+}
+
+// markdownCell escapes text to be placed inside a Markdown table cell.
+func markdownCell(text string) string {
+	text = strings.ReplaceAll(text, "|", "\\|")
+	return strings.Join(strings.Fields(text), " ")
+}
@@ -0,0 +1,93 @@
+package cosmosgen
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/pkg/cosmosanalysis/module"
+	"github.com/tendermint/starport/starport/pkg/protoanalysis"
+)
+
+// testOpenAPIDoc is the OpenAPI spec generated by protoc for a small module.
+const testOpenAPIDoc = `{
+  "swagger": "2.0",
+  "info": {"title": "mars/mars/query.proto", "version": "version not set"},
+  "paths": {
+    "/mars/mars/posts/{id}": {
+      "get": {
+        "summary": "Queries a post by id.",
+        "operationId": "Post",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {"$ref": "#/definitions/mars.mars.QueryGetPostResponse"}
+          }
+        },
+        "parameters": [
+          {"name": "id", "in": "path", "required": true, "type": "string", "format": "uint64"}
+        ]
+      }
+    },
+    "/mars/mars/posts": {
+      "post": {
+        "operationId": "CreatePost",
+        "responses": {
+          "200": {"description": "A successful response.", "schema": {"type": "object"}}
+        },
+        "parameters": [
+          {"name": "body", "in": "body", "required": true, "schema": {"$ref": "#/definitions/mars.mars.MsgCreatePost"}},
+          {"name": "dry_run", "in": "query", "required": false, "type": "boolean", "description": "Simulate | validate only."}
+        ]
+      }
+    }
+  },
+  "definitions": {
+    "mars.mars.Post": {
+      "type": "object",
+      "properties": {
+        "id": {"type": "string", "format": "uint64"},
+        "title": {"type": "string", "title": "title of the post"}
+      }
+    },
+    "mars.mars.QueryGetPostResponse": {
+      "type": "object",
+      "properties": {
+        "post": {"$ref": "#/definitions/mars.mars.Post"},
+        "tags": {"type": "array", "items": {"type": "string"}}
+      }
+    },
+    "mars.mars.MsgCreatePost": {
+      "type": "object",
+      "properties": {
+        "creator": {"type": "string"},
+        "title": {"type": "string"}
+      }
+    },
+    "mars.mars.MsgCreatePostResponse": {"type": "object"}
+  }
+}`
+
+// testDocModule is the module documented by testOpenAPIDoc.
+var testDocModule = module.Module{
+	Name: "mars",
+	Pkg:  protoanalysis.Package{Name: "mars.mars"},
+}
+
+func TestGenerateMarkdownDoc(t *testing.T) {
+	appPath := t.TempDir()
+	specPath := filepath.Join(t.TempDir(), "apidocs.swagger.json")
+	require.NoError(t, os.WriteFile(specPath, []byte(testOpenAPIDoc), 0644))
+
+	g, err := newGenerator(context.Background(), appPath, defaultProtoDir, WithMarkdownDocs("docs"))
+	require.NoError(t, err)
+	require.NoError(t, g.generateMarkdownDoc(specPath, testDocModule))
+
+	want, err := os.ReadFile("testdata/mars.mars.md")
+	require.NoError(t, err)
+	doc, err := os.ReadFile(filepath.Join(appPath, "docs", "mars.mars.md"))
+	require.NoError(t, err)
+	require.Equal(t, string(want), string(doc))
+}
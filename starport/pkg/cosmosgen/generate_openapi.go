@@ -1,6 +1,7 @@
 package cosmosgen
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 	"sort"
@@ -16,6 +17,10 @@ var openAPIOut = []string{
 }
 
 func generateOpenAPISpec(g *generator) error {
+	if g.o.openAPIVersion != openAPIV2 && g.o.openAPIVersion != openAPIV3 {
+		return fmt.Errorf("unsupported OpenAPI version %d", g.o.openAPIVersion)
+	}
+
 	out := filepath.Join(g.appPath, g.o.specOut)
 
 	var (
@@ -61,6 +66,13 @@ func generateOpenAPISpec(g *generator) error {
 		specDirs = append(specDirs, dir)
 
 		specPath := filepath.Join(dir, "apidocs.swagger.json")
+
+		if g.o.markdownOut != "" {
+			if err := g.generateMarkdownDoc(specPath, m); err != nil {
+				return err
+			}
+		}
+
 		return conf.AddSpec(strcase.ToCamel(m.Pkg.Name), specPath)
 	}
 
@@ -89,6 +101,11 @@ func generateOpenAPISpec(g *generator) error {
 		}
 	}
 
+	// only the module docs are requested.
+	if g.o.specOut == "" {
+		return nil
+	}
+
 	sort.Slice(conf.APIs, func(a, b int) bool { return conf.APIs[a].ID < conf.APIs[b].ID })
 
 	// ensure out dir exists.
@@ -98,5 +115,14 @@ func generateOpenAPISpec(g *generator) error {
 	}
 
 	// combine specs into one and save to out.
-	return swaggercombine.Combine(g.ctx, conf, out)
+	if err := swaggercombine.Combine(g.ctx, conf, out); err != nil {
+		return err
+	}
+
+	// protoc only generates OpenAPI v2 specs, so the combined spec is converted if v3 is requested.
+	if g.o.openAPIVersion == openAPIV3 {
+		return convertOpenAPISpecFile(out)
+	}
+
+	return nil
 }
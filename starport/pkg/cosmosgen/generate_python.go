@@ -0,0 +1,183 @@
+package cosmosgen
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"github.com/tendermint/starport/starport/pkg/cosmosanalysis/module"
+	"github.com/tendermint/starport/starport/pkg/protoc"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/semaphore"
+)
+
+var (
+	pythonOut = []string{
+		"--python_betterproto_out=.",
+	}
+)
+
+const (
+	defaultPythonBinary      = "python3"
+	pythonPluginName         = "protoc-gen-python_betterproto"
+	pythonPluginModuleName   = "betterproto.plugin"
+	pythonLoaderTemplateName = "init.py"
+	pythonLoaderName         = "__init__.py"
+)
+
+type pythonGenerator struct {
+	g *generator
+}
+
+func newPythonGenerator(g *generator) *pythonGenerator {
+	return &pythonGenerator{
+		g: g,
+	}
+}
+
+func (g *generator) generatePython() error {
+	pyg := newPythonGenerator(g)
+
+	if err := pyg.generateModules(); err != nil {
+		return err
+	}
+
+	return pyg.generateModuleLoaders()
+}
+
+func (g *pythonGenerator) generateModules() error {
+	pluginPath, cleanup, err := g.pluginPath()
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	gg := &errgroup.Group{}
+
+	for _, m := range g.g.appModules {
+		m := m
+		gg.Go(func() error { return g.generateModule(g.g.ctx, g.g.sem, pluginPath, g.g.appPath, m) })
+	}
+
+	return gg.Wait()
+}
+
+// generateModule generates Python code for a module.
+func (g *pythonGenerator) generateModule(
+	ctx context.Context,
+	sem *semaphore.Weighted,
+	pluginPath,
+	appPath string,
+	m module.Module,
+) error {
+	if !g.g.isModuleIncluded(m) {
+		return nil
+	}
+
+	// limit the number of simultaneous protoc invocations.
+	if err := sem.Acquire(ctx, 1); err != nil {
+		return err
+	}
+	defer sem.Release(1)
+
+	out := g.g.o.pythonOut(m)
+
+	includePaths, err := g.g.resolveInclude(appPath)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(out, 0766); err != nil {
+		return err
+	}
+
+	// generate betterproto types and grpc stubs.
+	return protoc.Generate(
+		ctx,
+		out,
+		m.Pkg.Path,
+		includePaths,
+		pythonOut,
+		protoc.Plugin(pluginPath),
+	)
+}
+
+// generateModuleLoaders generates an __init__.py file for each dir that contains generated modules
+// to expose them through a single package.
+func (g *pythonGenerator) generateModuleLoaders() error {
+	type module struct {
+		Name string
+		Path string
+	}
+
+	loaders := make(map[string][]module)
+	for _, m := range g.g.appModules {
+		if !g.g.isModuleIncluded(m) {
+			continue
+		}
+
+		out := g.g.o.pythonOut(m)
+		path := filepath.Base(out)
+		loaders[filepath.Dir(out)] = append(loaders[filepath.Dir(out)], module{
+			Name: strcase.ToSnake(path),
+			Path: path,
+		})
+	}
+
+	for dir, modules := range loaders {
+		sort.Slice(modules, func(i, j int) bool { return modules[i].Path < modules[j].Path })
+
+		data := struct {
+			Modules []module
+		}{
+			Modules: modules,
+		}
+		if err := g.g.writeTemplate(templatePythonRoot, dir, "", data); err != nil {
+			return err
+		}
+
+		// embedded files cannot start with an underscore, so the loader template is
+		// named differently and renamed after being written.
+		if err := os.Rename(filepath.Join(dir, pythonLoaderTemplateName), filepath.Join(dir, pythonLoaderName)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// pluginPath returns the path to a script that runs the betterproto plugin with the configured
+// Python binary so it can be passed to protoc via --plugin option.
+//
+// protoc is very picky about binary names of its plugins, so the script is named after the plugin.
+func (g *pythonGenerator) pluginPath() (path string, cleanup func(), err error) {
+	tmpdir, err := os.MkdirTemp("", "gen-python-plugin")
+	if err != nil {
+		return "", nil, err
+	}
+	cleanup = func() { os.RemoveAll(tmpdir) }
+
+	pythonBinary := g.g.o.pythonBinary
+	if pythonBinary == "" {
+		pythonBinary = defaultPythonBinary
+	}
+
+	// the binary path is single quoted so it is used as is by bash even if it contains spaces
+	// or special characters.
+	path = filepath.Join(tmpdir, pythonPluginName)
+	script := fmt.Sprintf(`#!/bin/bash
+PY='%s'
+exec "$PY" -m %s "$@"
+`, strings.ReplaceAll(pythonBinary, "'", `'\''`), pythonPluginModuleName)
+
+	if err := os.WriteFile(path, []byte(script), 0755); err != nil {
+		cleanup()
+		return "", nil, err
+	}
+
+	return path, cleanup, nil
+}
@@ -0,0 +1,29 @@
+package cosmosgen
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPythonPluginPath(t *testing.T) {
+	// the fake Python binary prints its arguments, its path contains spaces and quotes.
+	pythonBinary := filepath.Join(t.TempDir(), "python dir's $HOME", "python3")
+	require.NoError(t, os.MkdirAll(filepath.Dir(pythonBinary), 0755))
+	require.NoError(t, os.WriteFile(pythonBinary, []byte("#!/bin/bash\necho \"$@\"\n"), 0755))
+
+	g, err := newGenerator(context.Background(), t.TempDir(), defaultProtoDir, WithPythonBinary(pythonBinary))
+	require.NoError(t, err)
+
+	path, cleanup, err := newPythonGenerator(g).pluginPath()
+	require.NoError(t, err)
+	defer cleanup()
+
+	out, err := exec.Command(path, "foo", "bar baz").Output()
+	require.NoError(t, err)
+	require.Equal(t, "-m "+pythonPluginModuleName+" foo bar baz\n", string(out))
+}
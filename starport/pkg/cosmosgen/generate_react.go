@@ -0,0 +1,84 @@
+package cosmosgen
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/tendermint/starport/starport/pkg/cosmosanalysis/module"
+	"golang.org/x/sync/errgroup"
+)
+
+type reactGenerator struct {
+	g *generator
+}
+
+func newReactGenerator(g *generator) *reactGenerator {
+	return &reactGenerator{
+		g: g,
+	}
+}
+
+func (g *generator) generateReactHooks() error {
+	// hooks wrap the generated JS clients.
+	if g.o.jsOut == nil {
+		return errors.New("React Query hooks generation requires JS generation to be enabled")
+	}
+
+	return newReactGenerator(g).generateModules()
+}
+
+func (g *reactGenerator) generateModules() error {
+	gg := &errgroup.Group{}
+
+	add := func(modules []module.Module) {
+		for _, m := range modules {
+			m := m
+			gg.Go(func() error { return g.generateModule(m) })
+		}
+	}
+
+	add(g.g.appModules)
+
+	if g.g.o.jsIncludeThirdParty {
+		for _, modules := range g.g.thirdModules {
+			add(modules)
+		}
+	}
+
+	return gg.Wait()
+}
+
+// generateModule generates React Query hooks for a module.
+func (g *reactGenerator) generateModule(m module.Module) error {
+	if !g.g.isModuleIncluded(m) {
+		return nil
+	}
+
+	out := g.g.o.reactOut(m)
+
+	if err := os.MkdirAll(out, 0766); err != nil {
+		return err
+	}
+
+	// import the JS client relatively to the hooks.
+	clientPath, err := filepath.Rel(out, g.g.o.jsOut(m))
+	if err != nil {
+		return err
+	}
+	clientPath = filepath.ToSlash(clientPath)
+	if !strings.HasPrefix(clientPath, ".") {
+		clientPath = "./" + clientPath
+	}
+
+	data := struct {
+		Module     module.Module
+		ClientPath string
+	}{
+		Module:     m,
+		ClientPath: clientPath,
+	}
+
+	return g.g.writeTemplate(templateReactHooks, out, "", data)
+}
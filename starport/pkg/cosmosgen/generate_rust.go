@@ -0,0 +1,147 @@
+package cosmosgen
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/mattn/go-zglob"
+	"github.com/pkg/errors"
+	"github.com/tendermint/starport/starport/pkg/cosmosanalysis/module"
+	"github.com/tendermint/starport/starport/pkg/protoc"
+	"github.com/tendermint/starport/starport/pkg/xexec"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/semaphore"
+)
+
+var (
+	rustOut = []string{
+		"--prost_out=.",
+		"--tonic_out=.",
+	}
+
+	rustPlugins = []string{
+		"protoc-gen-prost",
+		"protoc-gen-tonic",
+	}
+)
+
+const rustSourceDirName = "src"
+
+type rustGenerator struct {
+	g *generator
+}
+
+func newRustGenerator(g *generator) *rustGenerator {
+	return &rustGenerator{
+		g: g,
+	}
+}
+
+func (g *generator) generateRust() error {
+	return newRustGenerator(g).generateModules()
+}
+
+func (g *rustGenerator) generateModules() error {
+	for _, plugin := range rustPlugins {
+		if !xexec.IsCommandAvailable(plugin) {
+			return errors.Errorf("%s protoc plugin is required for Rust code generation", plugin)
+		}
+	}
+
+	gg := &errgroup.Group{}
+
+	for _, m := range g.g.appModules {
+		m := m
+		gg.Go(func() error { return g.generateModule(g.g.ctx, g.g.sem, g.g.appPath, m) })
+	}
+
+	return gg.Wait()
+}
+
+// generateModule generates a Rust crate with prost types and tonic client for a module.
+func (g *rustGenerator) generateModule(ctx context.Context, sem *semaphore.Weighted, appPath string, m module.Module) error {
+	if !g.g.isModuleIncluded(m) {
+		return nil
+	}
+
+	// limit the number of simultaneous protoc invocations.
+	if err := sem.Acquire(ctx, 1); err != nil {
+		return err
+	}
+	defer sem.Release(1)
+
+	var (
+		out    = g.g.o.rustOut(m)
+		srcOut = filepath.Join(out, rustSourceDirName)
+	)
+
+	includePaths, err := g.g.resolveInclude(appPath)
+	if err != nil {
+		return err
+	}
+
+	// reset destination dir.
+	if err := os.RemoveAll(out); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(srcOut, 0766); err != nil {
+		return err
+	}
+
+	// generate prost types and tonic client.
+	if err := protoc.Generate(
+		ctx,
+		srcOut,
+		m.Pkg.Path,
+		includePaths,
+		rustOut,
+	); err != nil {
+		return err
+	}
+
+	// generate the crate files.
+	crateData := struct {
+		Module    module.Module
+		CrateName string
+	}{
+		Module:    m,
+		CrateName: rustCrateName(m),
+	}
+	if err := g.g.writeTemplate(templateRustCrate, out, "", crateData); err != nil {
+		return err
+	}
+
+	// generate a lib file that includes all generated code.
+	generatedFiles, err := zglob.Glob(filepath.Join(srcOut, "**/*.rs"))
+	if err != nil {
+		return err
+	}
+
+	var files []string
+	for _, file := range generatedFiles {
+		path, err := filepath.Rel(srcOut, file)
+		if err != nil {
+			return err
+		}
+		files = append(files, path)
+	}
+	sort.Strings(files)
+
+	srcData := struct {
+		Module module.Module
+		Files  []string
+	}{
+		Module: m,
+		Files:  files,
+	}
+	err = g.g.writeTemplate(templateRustSource, srcOut, "", srcData)
+	return errors.Wrap(err, "could not create the Rust lib file for module")
+}
+
+// rustCrateName returns the crate name for a module which is named after its proto package path.
+func rustCrateName(m module.Module) string {
+	return strings.ReplaceAll(strings.ToLower(m.Pkg.Name), ".", "-")
+}
@@ -0,0 +1,302 @@
+package cosmosgen
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+)
+
+const (
+	openAPIV2 = 2
+	openAPIV3 = 3
+
+	openAPIV3Version        = "3.0.0"
+	openAPIJSONMediaType    = "application/json"
+	openAPIV2DefinitionsRef = "#/definitions/"
+	openAPIV3SchemasRef     = "#/components/schemas/"
+)
+
+// openAPIOperationMethods is the list of path item fields that describe an operation.
+var openAPIOperationMethods = []string{"get", "put", "post", "delete", "options", "head", "patch"}
+
+// openAPIParamSchemaFields is the list of v2 parameter fields that are moved under schema in v3.
+var openAPIParamSchemaFields = []string{"type", "format", "items", "enum", "default", "collectionFormat"}
+
+// convertOpenAPISpecFile converts the OpenAPI v2 spec file at path to OpenAPI v3 in place.
+// the v2 spec file is removed when it cannot be converted.
+func convertOpenAPISpecFile(path string) (err error) {
+	defer func() {
+		if err != nil {
+			os.Remove(path)
+		}
+	}()
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var spec map[string]interface{}
+	if err := yaml.Unmarshal(content, &spec); err != nil {
+		return err
+	}
+
+	converted, err := convertOpenAPIV2ToV3(spec)
+	if err != nil {
+		return errors.Wrap(err, "cannot convert OpenAPI v2 spec")
+	}
+	if err := validateOpenAPIV3(converted); err != nil {
+		return errors.Wrap(err, "invalid OpenAPI v3 spec")
+	}
+
+	content, err = yaml.Marshal(converted)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, content, 0644)
+}
+
+// convertOpenAPIV2ToV3 converts a Swagger 2.0 spec to an OpenAPI 3.0 spec.
+func convertOpenAPIV2ToV3(spec map[string]interface{}) (map[string]interface{}, error) {
+	converted := map[string]interface{}{
+		"openapi": openAPIV3Version,
+		"info":    spec["info"],
+		"paths":   map[string]interface{}{},
+	}
+
+	for _, field := range []string{"tags", "security", "externalDocs"} {
+		if v, ok := spec[field]; ok {
+			converted[field] = v
+		}
+	}
+
+	if host, ok := spec["host"].(string); ok {
+		basePath, _ := spec["basePath"].(string)
+		schemes, _ := spec["schemes"].([]interface{})
+		if len(schemes) == 0 {
+			schemes = []interface{}{"https"}
+		}
+
+		var servers []interface{}
+		for _, scheme := range schemes {
+			servers = append(servers, map[string]interface{}{
+				"url": fmt.Sprintf("%s://%s%s", scheme, host, basePath),
+			})
+		}
+		converted["servers"] = servers
+	}
+
+	components := map[string]interface{}{}
+	if definitions, ok := spec["definitions"].(map[string]interface{}); ok {
+		components["schemas"] = definitions
+	}
+	if securityDefinitions, ok := spec["securityDefinitions"].(map[string]interface{}); ok {
+		components["securitySchemes"] = securityDefinitions
+	}
+	if len(components) > 0 {
+		converted["components"] = components
+	}
+
+	paths, _ := spec["paths"].(map[string]interface{})
+	convertedPaths := converted["paths"].(map[string]interface{})
+	for path, item := range paths {
+		pathItem, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		convertedPathItem, err := convertOpenAPIPathItem(pathItem)
+		if err != nil {
+			return nil, errors.Wrapf(err, "path %q", path)
+		}
+		convertedPaths[path] = convertedPathItem
+	}
+
+	return replaceOpenAPIRefs(converted).(map[string]interface{}), nil
+}
+
+// convertOpenAPIPathItem converts the operations of a v2 path item to v3.
+func convertOpenAPIPathItem(pathItem map[string]interface{}) (map[string]interface{}, error) {
+	converted := make(map[string]interface{})
+	for field, value := range pathItem {
+		converted[field] = value
+	}
+
+	if params, ok := pathItem["parameters"].([]interface{}); ok {
+		converted["parameters"], _ = convertOpenAPIParams(params)
+	}
+
+	for _, method := range openAPIOperationMethods {
+		operation, ok := pathItem[method].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		convertedOperation := make(map[string]interface{})
+		for field, value := range operation {
+			switch field {
+			case "consumes", "produces", "schemes":
+			case "parameters":
+				operationParams, ok := value.([]interface{})
+				if !ok {
+					return nil, fmt.Errorf("%s parameters must be a list", method)
+				}
+				params, requestBody := convertOpenAPIParams(operationParams)
+				if len(params) > 0 {
+					convertedOperation["parameters"] = params
+				}
+				if requestBody != nil {
+					convertedOperation["requestBody"] = requestBody
+				}
+			case "responses":
+				responses, ok := value.(map[string]interface{})
+				if !ok {
+					return nil, fmt.Errorf("%s responses must be an object", method)
+				}
+				convertedOperation["responses"] = convertOpenAPIResponses(responses)
+			default:
+				convertedOperation[field] = value
+			}
+		}
+		converted[method] = convertedOperation
+	}
+
+	return converted, nil
+}
+
+// convertOpenAPIParams converts v2 parameters to v3, body parameters are returned as a request body.
+func convertOpenAPIParams(params []interface{}) (converted []interface{}, requestBody map[string]interface{}) {
+	for _, p := range params {
+		param, ok := p.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if param["in"] == "body" {
+			requestBody = map[string]interface{}{
+				"content": map[string]interface{}{
+					openAPIJSONMediaType: map[string]interface{}{
+						"schema": param["schema"],
+					},
+				},
+			}
+			if required, ok := param["required"]; ok {
+				requestBody["required"] = required
+			}
+			if description, ok := param["description"]; ok {
+				requestBody["description"] = description
+			}
+			continue
+		}
+
+		convertedParam := make(map[string]interface{})
+		schema := make(map[string]interface{})
+		for field, value := range param {
+			if isOpenAPIParamSchemaField(field) {
+				if field != "collectionFormat" {
+					schema[field] = value
+				}
+				continue
+			}
+			convertedParam[field] = value
+		}
+		if len(schema) > 0 {
+			convertedParam["schema"] = schema
+		}
+		converted = append(converted, convertedParam)
+	}
+
+	return converted, requestBody
+}
+
+// convertOpenAPIResponses converts v2 responses to v3 by moving schemas under the response content.
+func convertOpenAPIResponses(responses map[string]interface{}) map[string]interface{} {
+	converted := make(map[string]interface{})
+	for code, r := range responses {
+		response, ok := r.(map[string]interface{})
+		if !ok {
+			converted[code] = r
+			continue
+		}
+
+		convertedResponse := make(map[string]interface{})
+		for field, value := range response {
+			if field == "schema" {
+				convertedResponse["content"] = map[string]interface{}{
+					openAPIJSONMediaType: map[string]interface{}{
+						"schema": value,
+					},
+				}
+				continue
+			}
+			convertedResponse[field] = value
+		}
+		if _, ok := convertedResponse["description"]; !ok {
+			convertedResponse["description"] = ""
+		}
+		converted[code] = convertedResponse
+	}
+	return converted
+}
+
+// replaceOpenAPIRefs replaces v2 definition references with v3 component references.
+func replaceOpenAPIRefs(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for field, fieldValue := range v {
+			if ref, ok := fieldValue.(string); ok && field == "$ref" {
+				v[field] = strings.Replace(ref, openAPIV2DefinitionsRef, openAPIV3SchemasRef, 1)
+				continue
+			}
+			v[field] = replaceOpenAPIRefs(fieldValue)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = replaceOpenAPIRefs(item)
+		}
+	}
+	return value
+}
+
+// validateOpenAPIV3 performs a lightweight structural check of an OpenAPI v3 spec.
+func validateOpenAPIV3(spec map[string]interface{}) error {
+	version, ok := spec["openapi"].(string)
+	if !ok || !strings.HasPrefix(version, "3.") {
+		return errors.New("openapi version field must be 3.x")
+	}
+
+	info, ok := spec["info"].(map[string]interface{})
+	if !ok {
+		return errors.New("info field is missing")
+	}
+	if _, ok := info["title"].(string); !ok {
+		return errors.New("info.title field is missing")
+	}
+
+	paths, ok := spec["paths"].(map[string]interface{})
+	if !ok {
+		return errors.New("paths field is missing")
+	}
+	for path, item := range paths {
+		if !strings.HasPrefix(path, "/") {
+			return fmt.Errorf("path %q must start with a slash", path)
+		}
+		if _, ok := item.(map[string]interface{}); !ok {
+			return fmt.Errorf("path %q must be an object", path)
+		}
+	}
+
+	return nil
+}
+
+func isOpenAPIParamSchemaField(field string) bool {
+	for _, f := range openAPIParamSchemaFields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
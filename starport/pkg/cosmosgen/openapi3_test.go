@@ -0,0 +1,163 @@
+package cosmosgen
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ghodss/yaml"
+	"github.com/stretchr/testify/require"
+)
+
+const openAPIV2Spec = `
+swagger: "2.0"
+info:
+  title: Mars
+  version: v1
+host: localhost:1317
+basePath: /api
+schemes: [http]
+tags: [{name: mars}]
+securityDefinitions:
+  key: {type: apiKey, name: key, in: header}
+paths:
+  /mars/posts/{id}:
+    parameters:
+      - {name: id, in: path, required: true, type: string}
+    get:
+      operationId: Post
+      produces: [application/json]
+      parameters:
+        - {name: page, in: query, type: integer, format: int64, collectionFormat: csv}
+      responses:
+        "200":
+          schema: {$ref: "#/definitions/mars.Post"}
+        default:
+          description: An unexpected error response.
+          schema: {$ref: "#/definitions/rpc.Status"}
+    post:
+      operationId: CreatePost
+      consumes: [application/json]
+      parameters:
+        - {name: body, in: body, required: true, description: The post., schema: {$ref: "#/definitions/mars.Post"}}
+      responses:
+        "200": {description: A successful response.}
+definitions:
+  mars.Post:
+    type: object
+    properties:
+      title: {type: string}
+  rpc.Status:
+    type: object
+`
+
+const openAPIV3Spec = `
+openapi: 3.0.0
+info:
+  title: Mars
+  version: v1
+servers:
+  - url: http://localhost:1317/api
+tags: [{name: mars}]
+components:
+  securitySchemes:
+    key: {type: apiKey, name: key, in: header}
+  schemas:
+    mars.Post:
+      type: object
+      properties:
+        title: {type: string}
+    rpc.Status:
+      type: object
+paths:
+  /mars/posts/{id}:
+    parameters:
+      - {name: id, in: path, required: true, schema: {type: string}}
+    get:
+      operationId: Post
+      parameters:
+        - {name: page, in: query, schema: {type: integer, format: int64}}
+      responses:
+        "200":
+          description: ""
+          content:
+            application/json:
+              schema: {$ref: "#/components/schemas/mars.Post"}
+        default:
+          description: An unexpected error response.
+          content:
+            application/json:
+              schema: {$ref: "#/components/schemas/rpc.Status"}
+    post:
+      operationId: CreatePost
+      requestBody:
+        required: true
+        description: The post.
+        content:
+          application/json:
+            schema: {$ref: "#/components/schemas/mars.Post"}
+      responses:
+        "200": {description: A successful response.}
+`
+
+func TestConvertOpenAPISpecFile(t *testing.T) {
+	tests := []struct {
+		name string
+		spec string
+		want string
+		err  string
+	}{
+		{
+			name: "convert",
+			spec: openAPIV2Spec,
+			want: openAPIV3Spec,
+		},
+		{
+			name: "default scheme",
+			spec: `{swagger: "2.0", info: {title: Mars}, host: localhost, paths: {}}`,
+			want: `{openapi: 3.0.0, info: {title: Mars}, servers: [{url: "https://localhost"}], paths: {}}`,
+		},
+		{
+			name: "invalid operation parameters",
+			spec: `{swagger: "2.0", info: {title: Mars}, paths: {/posts: {get: {parameters: {name: id}}}}}`,
+			err:  `cannot convert OpenAPI v2 spec: path "/posts": get parameters must be a list`,
+		},
+		{
+			name: "invalid operation responses",
+			spec: `{swagger: "2.0", info: {title: Mars}, paths: {/posts: {get: {responses: [ok]}}}}`,
+			err:  `cannot convert OpenAPI v2 spec: path "/posts": get responses must be an object`,
+		},
+		{
+			name: "missing title",
+			spec: `{swagger: "2.0", info: {version: v1}, paths: {}}`,
+			err:  "invalid OpenAPI v3 spec: info.title field is missing",
+		},
+		{
+			name: "invalid path",
+			spec: `{swagger: "2.0", info: {title: Mars}, paths: {posts: {}}}`,
+			err:  `invalid OpenAPI v3 spec: path "posts" must start with a slash`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "openapi.yml")
+			require.NoError(t, os.WriteFile(path, []byte(tt.spec), 0644))
+
+			err := convertOpenAPISpecFile(path)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				require.NoFileExists(t, path, "the v2 spec must be removed")
+				return
+			}
+			require.NoError(t, err)
+
+			content, err := os.ReadFile(path)
+			require.NoError(t, err)
+
+			var got, want interface{}
+			require.NoError(t, yaml.Unmarshal(content, &got))
+			require.NoError(t, yaml.Unmarshal([]byte(tt.want), &want))
+			require.Equal(t, want, got)
+		})
+	}
+}
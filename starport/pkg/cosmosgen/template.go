@@ -1,7 +1,9 @@
 package cosmosgen
 
 import (
+	"crypto/sha256"
 	"embed"
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
@@ -10,29 +12,82 @@ import (
 	"github.com/iancoleman/strcase"
 )
 
+const customTemplateExt = ".tmpl"
+
 var (
 	//go:embed templates/*
 	templates embed.FS
 
-	templateJSClient  = newTemplateWriter("js")         // js wrapper client.
-	templateVuexRoot  = newTemplateWriter("vuex/root")  // vuex store loader.
-	templateVuexStore = newTemplateWriter("vuex/store") // vuex store.
+	templateJSClient   = newTemplateWriter("js", "js_client", "index.ts.tpl")                 // js wrapper client.
+	templateVuexRoot   = newTemplateWriter("vuex/root", "vuex_root", "index.ts.tpl")          // vuex store loader.
+	templateVuexStore  = newTemplateWriter("vuex/store", "vuex_store", "index.ts.tpl")        // vuex store.
+	templateReactHooks = newTemplateWriter("react", "react_hooks", "hooks.ts.tpl")            // react query hooks.
+	templateMarkdown   = newTemplateWriter("markdown", "markdown", "module.md.tpl")           // markdown module docs.
+	templateGraphQL    = newTemplateWriter("graphql", "graphql_schema", "schema.graphql.tpl") // graphql schema and resolvers.
+	templatePythonRoot = newTemplateWriter("python", "python_loader", "init.py.tpl")          // python module loader.
+	templateRustCrate  = newTemplateWriter("rust/crate", "rust_crate", "Cargo.toml.tpl")      // rust crate files.
+	templateRustSource = newTemplateWriter("rust/src", "rust_lib", "lib.rs.tpl")              // rust lib file.
 
 )
 
 type templateWriter struct {
 	templateDir string
+
+	// customName is the name of the custom template that can be used instead of the main template.
+	customName string
+
+	// mainFile is the name of the template file inside templateDir that can be overridden.
+	mainFile string
 }
 
 // tpl returns a func for template residing at templatePath to initialize a text template
 // with given protoPath.
-func newTemplateWriter(templateDir string) templateWriter {
+func newTemplateWriter(templateDir, customName, mainFile string) templateWriter {
 	return templateWriter{
 		templateDir,
+		customName,
+		mainFile,
+	}
+}
+
+// checksum calculates the sha256 checksum of the template files, the custom template found in customDir
+// is included when there is one.
+func (t templateWriter) checksum(customDir string) (string, error) {
+	base := filepath.Join("templates", t.templateDir)
+
+	files, err := templates.ReadDir(base)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+	for _, file := range files {
+		content, err := templates.ReadFile(filepath.Join(base, file.Name()))
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(h, "%s\n%s", file.Name(), content)
 	}
+
+	if customDir != "" {
+		content, err := os.ReadFile(filepath.Join(customDir, t.customName+customTemplateExt))
+		if err != nil && !os.IsNotExist(err) {
+			return "", err
+		}
+		fmt.Fprintf(h, "custom\n%s", content)
+	}
+
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
 }
 
-func (t templateWriter) Write(destDir, protoPath string, data interface{}) error {
+// writeTemplate writes the template by using the custom templates of the generator when configured.
+func (g *generator) writeTemplate(t templateWriter, destDir, protoPath string, data interface{}) error {
+	return t.Write(g.o.customTemplatesDir, destDir, protoPath, data)
+}
+
+// Write writes the template to destDir. when customDir is set, the custom template found in it is
+// used instead of the main template, the built-in template is used when there is no custom template.
+func (t templateWriter) Write(customDir, destDir, protoPath string, data interface{}) error {
 	base := filepath.Join("templates", t.templateDir)
 
 	// find out templates inside the dir.
@@ -46,6 +101,17 @@ func (t templateWriter) Write(destDir, protoPath string, data interface{}) error
 		paths = append(paths, filepath.Join(base, file.Name()))
 	}
 
+	// find out the custom template if there is one.
+	var customPath string
+	if customDir != "" {
+		path := filepath.Join(customDir, t.customName+customTemplateExt)
+		if _, err := os.Stat(path); err == nil {
+			customPath = path
+		} else if !os.IsNotExist(err) {
+			return err
+		}
+	}
+
 	funcs := template.FuncMap{
 		"camelCase": strcase.ToLowerCamel,
 		"resolveFile": func(fullPath string) string {
@@ -61,13 +127,25 @@ func (t templateWriter) Write(destDir, protoPath string, data interface{}) error
 
 	// render and write the template.
 	write := func(path string) error {
-		tpl := template.
-			Must(
-				template.
-					New(filepath.Base(path)).
-					Funcs(funcs).
-					ParseFS(templates, paths...),
-			)
+		var tpl *template.Template
+
+		if customPath != "" && filepath.Base(path) == t.mainFile {
+			tpl, err = template.
+				New(filepath.Base(customPath)).
+				Funcs(funcs).
+				ParseFiles(customPath)
+			if err != nil {
+				return err
+			}
+		} else {
+			tpl = template.
+				Must(
+					template.
+						New(filepath.Base(path)).
+						Funcs(funcs).
+						ParseFS(templates, paths...),
+				)
+		}
 
 		out := filepath.Join(destDir, strings.TrimSuffix(filepath.Base(path), ".tpl"))
 
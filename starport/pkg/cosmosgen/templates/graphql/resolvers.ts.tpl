@@ -0,0 +1,35 @@
+// THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+
+// resolvers for Apollo Server that forward GraphQL operations to the chain's REST API.
+const apiURL = process.env.API_URL ?? "http://localhost:1317";
+
+async function request(method: string, path: string, query: Record<string, any> = {}, body?: any) {
+  const url = new URL(path, apiURL);
+  for (const [key, value] of Object.entries(query)) {
+    if (value !== undefined && value !== null) {
+      url.searchParams.append(key, String(value));
+    }
+  }
+  const res = await fetch(url.toString(), {
+    method,
+    headers: { "Content-Type": "application/json" },
+    body: body === undefined ? undefined : JSON.stringify(body),
+  });
+  if (!res.ok) {
+    throw new Error(`${method} ${path} failed: ${res.status} ${res.statusText}`);
+  }
+  return res.json();
+}
+
+export const resolvers = {
+{{- if .Queries }}
+  Query: {
+{{ range .Queries }}    {{ .Name }}: (_parent: any, args: any) => request("{{ .Method }}", `{{ .Path }}`, { {{ range .QueryArgs }}"{{ .Original }}": args.{{ .Name }}, {{ end }}}{{ if .HasBody }}, args.body{{ end }}),
+{{ end }}  },
+{{- end }}
+{{- if .Mutations }}
+  Mutation: {
+{{ range .Mutations }}    {{ .Name }}: (_parent: any, args: any) => request("{{ .Method }}", `{{ .Path }}`, { {{ range .QueryArgs }}"{{ .Original }}": args.{{ .Name }}, {{ end }}}{{ if .HasBody }}, args.body{{ end }}),
+{{ end }}  },
+{{- end }}
+};
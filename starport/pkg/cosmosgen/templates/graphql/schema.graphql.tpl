@@ -0,0 +1,18 @@
+# THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+
+scalar JSON
+{{ range .Types }}
+type {{ .Name }} {
+{{ range .Fields }}  {{ .Name }}: {{ .Type }}
+{{ end }}}
+{{ end }}
+{{- if .Queries }}
+type Query {
+{{ range .Queries }}  {{ .Name }}{{ if .Args }}({{ range $i, $a := .Args }}{{ if (gt $i 0) }}, {{ end }}{{ $a.Name }}: {{ $a.Type }}{{ end }}){{ end }}: {{ .ReturnType }}
+{{ end }}}
+{{ end }}
+{{- if .Mutations }}
+type Mutation {
+{{ range .Mutations }}  {{ .Name }}{{ if .Args }}({{ range $i, $a := .Args }}{{ if (gt $i 0) }}, {{ end }}{{ $a.Name }}: {{ $a.Type }}{{ end }}){{ end }}: {{ .ReturnType }}
+{{ end }}}
+{{ end }}
@@ -0,0 +1,39 @@
+<!-- THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY. -->
+
+# {{ .Module.Pkg.Name }}
+{{ range .Operations }}
+## {{ .Name }}
+
+`{{ .Method }} {{ .Path }}`
+{{ if .Description }}
+{{ .Description }}
+{{ end }}
+{{- if .PathParams }}
+### Path Parameters
+
+| Name | Type | Description |
+| ---- | ---- | ----------- |
+{{ range .PathParams }}| {{ .Name }} | {{ .Type }} | {{ .Description }} |
+{{ end }}{{ end }}
+{{- if .QueryParams }}
+### Query Parameters
+
+| Name | Type | Required | Description |
+| ---- | ---- | -------- | ----------- |
+{{ range .QueryParams }}| {{ .Name }} | {{ .Type }} | {{ .Required }} | {{ .Description }} |
+{{ end }}{{ end }}
+{{- if .Request }}
+### Request `{{ .Request.Name }}`
+{{ if .Request.Fields }}
+| Field | Type | Description |
+| ----- | ---- | ----------- |
+{{ range .Request.Fields }}| {{ .Name }} | {{ .Type }} | {{ .Description }} |
+{{ end }}{{ end }}{{ end }}
+{{- if .Response }}
+### Response `{{ .Response.Name }}`
+{{ if .Response.Fields }}
+| Field | Type | Description |
+| ----- | ---- | ----------- |
+{{ range .Response.Fields }}| {{ .Name }} | {{ .Type }} | {{ .Description }} |
+{{ end }}{{ end }}{{ end }}
+{{- end }}
@@ -0,0 +1,7 @@
+# THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+
+{{ range .Modules }}from . import {{ .Path }} as {{ .Name }}
+{{ end }}
+__all__ = [
+{{ range .Modules }}    "{{ .Name }}",
+{{ end }}]
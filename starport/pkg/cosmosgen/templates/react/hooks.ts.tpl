@@ -0,0 +1,38 @@
+// THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+
+import { useQuery, UseQueryOptions } from "@tanstack/react-query";
+import { queryClient } from "{{ .ClientPath }}";
+
+interface QueryHookOptions {
+  addr?: string
+  options?: UseQueryOptions<any, Error>
+}
+
+{{ range .Module.HTTPQueries }}
+{{ $FullName := .FullName }}
+{{ range $i,$rule := .Rules }}
+{{ $n := "" }}
+{{ if (gt $i 0) }}
+{{ $n = inc $i }}
+{{ end }}
+export function use{{ $FullName }}{{ $n }}(params: any = {}, query: any = null, { addr, options }: QueryHookOptions = {}) {
+  return useQuery<any, Error>(["{{ $FullName }}{{ $n }}", params, query], async () => {
+    const client = await queryClient(addr ? { addr } : undefined);
+    return (await client.{{ camelCase $FullName -}}
+    {{- $n -}}(
+      {{- range $j,$a :=$rule.Params -}}
+        {{- if (gt $j 0) -}}, {{ end }} params.{{ $a -}}
+      {{- end -}}
+      {{- if $rule.HasQuery -}}
+        {{- if $rule.Params -}}, {{ end -}}
+        query
+      {{- end -}}
+      {{- if $rule.HasBody -}}
+        {{- if or $rule.HasQuery $rule.Params}},{{ end -}}
+        {...params}
+      {{- end -}}
+    )).data;
+  }, options);
+}
+{{ end }}
+{{ end }}
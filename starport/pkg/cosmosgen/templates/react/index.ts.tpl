@@ -0,0 +1,3 @@
+// THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+
+export * from "./hooks";
@@ -0,0 +1,11 @@
+# THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+
+[package]
+name = "{{ .CrateName }}"
+version = "0.1.0"
+edition = "2021"
+
+[dependencies]
+prost = "0.9"
+prost-types = "0.9"
+tonic = "0.6"
@@ -0,0 +1,7 @@
+// THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+
+// sources under src are generated ahead of time by protoc-gen-prost and protoc-gen-tonic,
+// rebuild the crate only when they change.
+fn main() {
+    println!("cargo:rerun-if-changed=src");
+}
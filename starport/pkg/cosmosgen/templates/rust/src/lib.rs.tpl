@@ -0,0 +1,4 @@
+// THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+
+{{ range .Files }}include!("{{ . }}");
+{{ end }}
@@ -8,6 +8,16 @@ export default {
   {{ end }}
 }
 
+// stores grouped by the chain namespace they belong to, to avoid name collisions
+// between modules of different source repos.
+export const namespaces = {
+  {{ range .Namespaces }}{{ .Name }}: {
+    {{ range .Modules }}{{ .Name }}: load({{ .FullName }}, '{{ .Path }}'),
+    {{ end }}
+  },
+  {{ end }}
+}
+
 
 function load(mod, fullns) {
     return function init(store) {        
@@ -0,0 +1,31 @@
+// THIS FILE IS GENERATED AUTOMATICALLY. DO NOT MODIFY.
+
+// resolvers for Apollo Server that forward GraphQL operations to the chain's REST API.
+const apiURL = process.env.API_URL ?? "http://localhost:1317";
+
+async function request(method: string, path: string, query: Record<string, any> = {}, body?: any) {
+  const url = new URL(path, apiURL);
+  for (const [key, value] of Object.entries(query)) {
+    if (value !== undefined && value !== null) {
+      url.searchParams.append(key, String(value));
+    }
+  }
+  const res = await fetch(url.toString(), {
+    method,
+    headers: { "Content-Type": "application/json" },
+    body: body === undefined ? undefined : JSON.stringify(body),
+  });
+  if (!res.ok) {
+    throw new Error(`${method} ${path} failed: ${res.status} ${res.statusText}`);
+  }
+  return res.json();
+}
+
+export const resolvers = {
+  Query: {
+    post: (_parent: any, args: any) => request("GET", `/mars/mars/posts/${args.id}`, { }),
+  },
+  Mutation: {
+    createPost: (_parent: any, args: any) => request("POST", `/mars/mars/posts`, { "dry_run": args.dry_run, }, args.body),
+  },
+};
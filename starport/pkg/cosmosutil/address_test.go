@@ -65,6 +65,51 @@ func TestChangePrefix(t *testing.T) {
 	}
 }
 
+func TestChangeAddressPrefixRoundTrip(t *testing.T) {
+	tests := []struct {
+		name    string
+		address string
+		prefix  string
+	}{
+		{
+			name:    "cosmos address",
+			address: "cosmos1dd246yq6z5vzjz9gh8cff46pll75yyl8ygndsj",
+			prefix:  "spn",
+		},
+		{
+			name:    "osmosis address",
+			address: "osmo1dd246yq6z5vzjz9gh8cff46pll75yyl8vnqaxq",
+			prefix:  "cosmos",
+		},
+		{
+			name:    "address with a numeric suffix prefix",
+			address: "terra21dd246yq6z5vzjz9gh8cff46pll75yyl86jwvvr",
+			prefix:  "cosmos",
+		},
+		{
+			name:    "address to a numeric suffix prefix",
+			address: "cosmos1dd246yq6z5vzjz9gh8cff46pll75yyl8ygndsj",
+			prefix:  "chain1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			origPrefix, err := cosmosutil.GetAddressPrefix(tt.address)
+			require.NoError(t, err)
+
+			changed, err := cosmosutil.ChangeAddressPrefix(tt.address, tt.prefix)
+			require.NoError(t, err)
+			prefix, err := cosmosutil.GetAddressPrefix(changed)
+			require.NoError(t, err)
+			require.Equal(t, tt.prefix, prefix)
+
+			got, err := cosmosutil.ChangeAddressPrefix(changed, origPrefix)
+			require.NoError(t, err)
+			require.Equal(t, tt.address, got)
+		})
+	}
+}
+
 func TestGetPrefix(t *testing.T) {
 	prefix, err := cosmosutil.GetAddressPrefix("cosmos1dd246yq6z5vzjz9gh8cff46pll75yyl8ygndsj")
 	require.Equal(t, "cosmos", prefix)
@@ -0,0 +1,42 @@
+package cosmosutil
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ErrInvalidCoins is returned when a coins string cannot be used as an amount of coins
+type ErrInvalidCoins struct {
+	Input  string
+	Reason string
+}
+
+// Error implements error
+func (e ErrInvalidCoins) Error() string {
+	return fmt.Sprintf("invalid coins %q: %s", e.Input, e.Reason)
+}
+
+// IsValidCoinStr checks the coins string is a valid list of coins without zero amounts,
+// an ErrInvalidCoins error is returned otherwise
+func IsValidCoinStr(coins string) error {
+	if strings.TrimSpace(coins) == "" {
+		return ErrInvalidCoins{Input: coins, Reason: "no coins"}
+	}
+	if _, err := sdk.ParseCoinsNormalized(coins); err != nil {
+		return ErrInvalidCoins{Input: coins, Reason: err.Error()}
+	}
+
+	// coins with a zero amount are silently removed when parsed
+	for _, coin := range strings.Split(coins, ",") {
+		decCoin, err := sdk.ParseDecCoin(coin)
+		if err != nil {
+			return ErrInvalidCoins{Input: coins, Reason: err.Error()}
+		}
+		if decCoin.IsZero() {
+			return ErrInvalidCoins{Input: coins, Reason: fmt.Sprintf("zero amount for %s", decCoin.Denom)}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package cosmosutil_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/pkg/cosmosutil"
+)
+
+func TestIsValidCoinStr(t *testing.T) {
+	tests := []struct {
+		name    string
+		coins   string
+		wantErr bool
+	}{
+		{
+			name:  "single coin",
+			coins: "1000stake",
+		},
+		{
+			name:  "multiple coins",
+			coins: "1000foo,500stake",
+		},
+		{
+			name:    "empty coins",
+			coins:   "",
+			wantErr: true,
+		},
+		{
+			name:    "malformed coins",
+			coins:   "foo1000",
+			wantErr: true,
+		},
+		{
+			name:    "zero amount",
+			coins:   "1000foo,0stake",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := cosmosutil.IsValidCoinStr(tt.coins)
+			if tt.wantErr {
+				require.ErrorAs(t, err, &cosmosutil.ErrInvalidCoins{})
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
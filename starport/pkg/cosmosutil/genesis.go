@@ -7,13 +7,32 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
+	"math/big"
 	"net/http"
 	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
 	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+const (
+	genesisTimeField            = "genesis_time"
+	genesisChainIDField         = "chain_id"
+	genesisConsensusParamsField = "consensus_params"
+	genesisAppStateField        = "app_state"
 )
 
-const genesisTimeField = "genesis_time"
+// chainIDRe matches the valid chain IDs
+var chainIDRe = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9._-]*$`)
 
 // ChainGenesis represents the stargate genesis file
 type ChainGenesis struct {
@@ -85,6 +104,69 @@ func SetGenesisTime(genesisPath string, genesisTime int64) error {
 	return os.WriteFile(genesisPath, genesisBytes, 0644)
 }
 
+// SetGenesisChainID sets the chain ID inside a genesis file
+func SetGenesisChainID(genesisPath, chainID string) error {
+	if !chainIDRe.MatchString(chainID) {
+		return fmt.Errorf("invalid chain ID %q, it must match %s", chainID, chainIDRe)
+	}
+
+	// fetch and parse genesis
+	genesisBytes, err := os.ReadFile(genesisPath)
+	if err != nil {
+		return err
+	}
+
+	var genesis map[string]interface{}
+	if err := json.Unmarshal(genesisBytes, &genesis); err != nil {
+		return err
+	}
+
+	// modify and save the new genesis
+	genesis[genesisChainIDField] = chainID
+	if consensusParams, ok := genesis[genesisConsensusParamsField].(map[string]interface{}); ok {
+		if _, ok := consensusParams[genesisChainIDField]; ok {
+			consensusParams[genesisChainIDField] = chainID
+		}
+	}
+	genesisBytes, err = json.Marshal(genesis)
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(genesisPath, genesisBytes)
+}
+
+// writeFileAtomic writes data into a temporary file that replaces the file at path once written,
+// the file keeps its permissions if it already exists
+func writeFileAtomic(path string, data []byte) error {
+	mode := os.FileMode(0644)
+	info, err := os.Stat(path)
+	switch {
+	case err == nil:
+		mode = info.Mode()
+	case !os.IsNotExist(err):
+		return err
+	}
+
+	tmpFile, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile.Name())
+
+	if _, err := tmpFile.Write(data); err != nil {
+		tmpFile.Close()
+		return err
+	}
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmpFile.Name(), mode); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpFile.Name(), path)
+}
+
 // GenesisAndHashFromURL fetches the genesis from the given url and returns its content along with the sha256 hash.
 func GenesisAndHashFromURL(ctx context.Context, url string) (genesis []byte, hash string, err error) {
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
@@ -112,3 +194,342 @@ func GenesisAndHashFromURL(ctx context.Context, url string) (genesis []byte, has
 
 	return genesis, hexHash, nil
 }
+
+// GenesisAccount is an account of the auth module state of a genesis
+type GenesisAccount struct {
+	Address       string
+	AccountNumber uint64
+	Sequence      uint64
+	Vesting       bool
+}
+
+// ParseGenesisAccounts returns the accounts of the auth module state of a genesis file,
+// the accounts are decoded with the Cosmos SDK codec to ensure they are valid accounts
+func ParseGenesisAccounts(genesisPath string) ([]GenesisAccount, error) {
+	genesisFile, err := os.ReadFile(genesisPath)
+	if err != nil {
+		return nil, errors.New("cannot open genesis file: " + err.Error())
+	}
+
+	var genesis struct {
+		AppState struct {
+			Auth struct {
+				Accounts []json.RawMessage `json:"accounts"`
+			} `json:"auth"`
+		} `json:"app_state"`
+	}
+	if err := json.Unmarshal(genesisFile, &genesis); err != nil {
+		return nil, err
+	}
+
+	registry := codectypes.NewInterfaceRegistry()
+	authtypes.RegisterInterfaces(registry)
+	vestingtypes.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	accounts := make([]GenesisAccount, 0, len(genesis.AppState.Auth.Accounts))
+	for i, rawAccount := range genesis.AppState.Auth.Accounts {
+		var account authtypes.GenesisAccount
+		if err := cdc.UnmarshalInterfaceJSON(rawAccount, &account); err != nil {
+			return nil, fmt.Errorf("cannot decode genesis account %d: %w", i, err)
+		}
+
+		// the address is read from the base account because the account getter
+		// only decodes addresses using the prefix of the global SDK config
+		var (
+			baseAccount *authtypes.BaseAccount
+			vesting     bool
+		)
+		switch acc := account.(type) {
+		case *authtypes.BaseAccount:
+			baseAccount = acc
+		case *authtypes.ModuleAccount:
+			baseAccount = acc.BaseAccount
+		case *vestingtypes.ContinuousVestingAccount:
+			baseAccount, vesting = acc.BaseAccount, true
+		case *vestingtypes.DelayedVestingAccount:
+			baseAccount, vesting = acc.BaseAccount, true
+		case *vestingtypes.PeriodicVestingAccount:
+			baseAccount, vesting = acc.BaseAccount, true
+		case *vestingtypes.PermanentLockedAccount:
+			baseAccount, vesting = acc.BaseAccount, true
+		default:
+			return nil, fmt.Errorf("unsupported type %T for genesis account %d", account, i)
+		}
+
+		accounts = append(accounts, GenesisAccount{
+			Address:       baseAccount.Address,
+			AccountNumber: baseAccount.AccountNumber,
+			Sequence:      baseAccount.Sequence,
+			Vesting:       vesting,
+		})
+	}
+
+	return accounts, nil
+}
+
+// MergeGenesisFiles deep-merges the app state of the overlay genesis file into the app state of the base
+// genesis file and writes the result to out. the chain IDs of both genesis files must be the same, the other
+// fields outside of the app state are the ones of the base genesis file.
+// list elements are deduplicated by their natural key, e.g. the address for accounts and balances and the
+// denom for coins, the supply of a denom is the sum of its supplies and a non-list field having different
+// values in both genesis files is a conflict. accounts can't share an account number once merged
+func MergeGenesisFiles(base, overlay, out string) error {
+	baseGenesis, err := readGenesisObject(base)
+	if err != nil {
+		return err
+	}
+
+	// a genesis file merged with itself is unchanged
+	sameFile, err := isSameFile(base, overlay)
+	if err != nil {
+		return err
+	}
+	if !sameFile {
+		overlayGenesis, err := readGenesisObject(overlay)
+		if err != nil {
+			return err
+		}
+		if err := mergeGenesisObjects(baseGenesis, overlayGenesis); err != nil {
+			return err
+		}
+	}
+
+	genesisBytes, err := json.MarshalIndent(baseGenesis, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(out, genesisBytes)
+}
+
+// mergeGenesisObjects merges the chain ID and the app state of the overlay genesis into the base genesis
+func mergeGenesisObjects(base, overlay map[string]interface{}) error {
+	for _, field := range []string{genesisChainIDField, genesisAppStateField} {
+		overlayValue, ok := overlay[field]
+		if !ok {
+			continue
+		}
+		baseValue, ok := base[field]
+		if !ok {
+			base[field] = overlayValue
+			continue
+		}
+		merged, err := mergeGenesisValues(field, baseValue, overlayValue)
+		if err != nil {
+			return err
+		}
+		base[field] = merged
+	}
+
+	accounts, _ := genesisField(base, genesisAppStateField, "auth", "accounts").([]interface{})
+	return checkGenesisAccountNumbers(accounts)
+}
+
+// genesisListKeys returns the natural key of the elements of a genesis list by the name of the list
+var genesisListKeys = map[string]func(element interface{}) string{
+	"accounts":       genesisElementAddress,
+	"balances":       genesisElementAddress,
+	"supply":         genesisElementField("denom"),
+	"coins":          genesisElementField("denom"),
+	"denom_metadata": genesisElementField("base"),
+	"validators":     genesisElementField("operator_address"),
+}
+
+// genesisListMerges merges the elements with the same natural key by the name of their list,
+// the elements of the other lists are deep-merged
+var genesisListMerges = map[string]func(path string, base, overlay interface{}) (interface{}, error){
+	"supply": sumGenesisCoins,
+}
+
+// isSameFile checks if both paths are the same file
+func isSameFile(path, otherPath string) (bool, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return false, err
+	}
+	otherInfo, err := os.Stat(otherPath)
+	if err != nil {
+		return false, err
+	}
+	return os.SameFile(info, otherInfo), nil
+}
+
+// readGenesisObject reads and decodes the genesis file at path
+func readGenesisObject(path string) (map[string]interface{}, error) {
+	genesisBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var genesis map[string]interface{}
+	if err := json.Unmarshal(genesisBytes, &genesis); err != nil {
+		return nil, fmt.Errorf("cannot decode genesis file %s: %w", path, err)
+	}
+	return genesis, nil
+}
+
+// genesisField returns the value of the genesis field at path or nil if the field doesn't exist
+func genesisField(value interface{}, path ...string) interface{} {
+	for _, key := range path {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = object[key]
+	}
+	return value
+}
+
+// mergeGenesisValues merges the values of a genesis field located at path
+func mergeGenesisValues(path string, base, overlay interface{}) (interface{}, error) {
+	switch baseValue := base.(type) {
+	case map[string]interface{}:
+		overlayValue, ok := overlay.(map[string]interface{})
+		if !ok {
+			break
+		}
+		for key, value := range overlayValue {
+			existing, ok := baseValue[key]
+			if !ok {
+				baseValue[key] = value
+				continue
+			}
+			merged, err := mergeGenesisValues(path+"."+key, existing, value)
+			if err != nil {
+				return nil, err
+			}
+			baseValue[key] = merged
+		}
+		return baseValue, nil
+
+	case []interface{}:
+		overlayValue, ok := overlay.([]interface{})
+		if !ok {
+			break
+		}
+		return mergeGenesisLists(path, baseValue, overlayValue)
+
+	default:
+		if reflect.DeepEqual(base, overlay) {
+			return base, nil
+		}
+	}
+
+	return nil, fmt.Errorf("conflicting values for genesis field %s", path)
+}
+
+// mergeGenesisLists appends the elements of the overlay list missing from the base list,
+// elements with the same natural key are merged and the other elements are compared by value
+func mergeGenesisLists(path string, base, overlay []interface{}) ([]interface{}, error) {
+	listName := path[strings.LastIndex(path, ".")+1:]
+	elementKey := genesisListKeys[listName]
+	mergeElements, ok := genesisListMerges[listName]
+	if !ok {
+		mergeElements = mergeGenesisValues
+	}
+
+	for _, element := range overlay {
+		var key string
+		if elementKey != nil {
+			key = elementKey(element)
+		}
+
+		var found bool
+		for i, existing := range base {
+			if key == "" || key != elementKey(existing) {
+				if reflect.DeepEqual(existing, element) {
+					found = true
+					break
+				}
+				continue
+			}
+
+			merged, err := mergeElements(fmt.Sprintf("%s[%s]", path, key), existing, element)
+			if err != nil {
+				return nil, err
+			}
+			base[i] = merged
+			found = true
+			break
+		}
+
+		if !found {
+			base = append(base, element)
+		}
+	}
+	return base, nil
+}
+
+// genesisElementAddress returns the address of a genesis list element or an empty string if it has no address
+func genesisElementAddress(element interface{}) string {
+	object, ok := element.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	if address, ok := object["address"].(string); ok {
+		return address
+	}
+
+	// the address of vesting accounts is stored in their base account
+	if vestingAccount, ok := object["base_vesting_account"].(map[string]interface{}); ok {
+		return genesisElementAddress(vestingAccount["base_account"])
+	}
+	return ""
+}
+
+// genesisElementField returns the natural key of the genesis list elements stored in their field,
+// the key of an element without the field is an empty string
+func genesisElementField(field string) func(element interface{}) string {
+	return func(element interface{}) string {
+		key, _ := genesisField(element, field).(string)
+		return key
+	}
+}
+
+// sumGenesisCoins sums the amounts of two genesis coins with the same denom
+func sumGenesisCoins(path string, base, overlay interface{}) (interface{}, error) {
+	baseAmount, ok := genesisCoinAmount(base)
+	if !ok {
+		return nil, fmt.Errorf("invalid amount for genesis field %s", path)
+	}
+	overlayAmount, ok := genesisCoinAmount(overlay)
+	if !ok {
+		return nil, fmt.Errorf("invalid amount for genesis field %s", path)
+	}
+
+	coin := base.(map[string]interface{})
+	coin["amount"] = baseAmount.Add(baseAmount, overlayAmount).String()
+	return coin, nil
+}
+
+// genesisCoinAmount returns the amount of a genesis coin
+func genesisCoinAmount(coin interface{}) (*big.Int, bool) {
+	amount, ok := genesisField(coin, "amount").(string)
+	if !ok {
+		return nil, false
+	}
+	return new(big.Int).SetString(amount, 10)
+}
+
+// checkGenesisAccountNumbers checks the genesis accounts have different account numbers, the accounts
+// added with the add-genesis-account command all have the 0 account number, which is assigned by the
+// chain at genesis, so only the other account numbers must be unique
+func checkGenesisAccountNumbers(accounts []interface{}) error {
+	addresses := make(map[string]string)
+	for _, account := range accounts {
+		number, ok := genesisField(account, "account_number").(string)
+		if !ok {
+			// the account number of vesting accounts is stored in their base account
+			number, _ = genesisField(account, "base_vesting_account", "base_account", "account_number").(string)
+		}
+		if number == "" || number == "0" {
+			continue
+		}
+
+		address := genesisElementAddress(account)
+		if other, ok := addresses[number]; ok {
+			return fmt.Errorf("genesis accounts %s and %s have the same account number %s", other, address, number)
+		}
+		addresses[number] = address
+	}
+	return nil
+}
@@ -44,3 +44,316 @@ func TestSetGenesisTime(t *testing.T) {
 	require.Equal(t, "bar", actual.Foo)
 	require.Equal(t, rfcTime, actual.GenesisTime)
 }
+
+func TestSetGenesisChainID(t *testing.T) {
+	tmpGenesis := filepath.Join(t.TempDir(), "genesis.json")
+
+	// fails with no file
+	require.Error(t, cosmosutil.SetGenesisChainID(tmpGenesis, "earth-1"))
+
+	require.NoError(t, os.WriteFile(tmpGenesis, []byte(`{
+  "foo": "bar",
+  "chain_id": "earth-1",
+  "consensus_params": {"chain_id": "earth-1", "block": {}}
+}`), 0644))
+
+	// fails with an invalid chain ID
+	require.Error(t, cosmosutil.SetGenesisChainID(tmpGenesis, "1earth"))
+	require.Error(t, cosmosutil.SetGenesisChainID(tmpGenesis, "earth 1"))
+
+	require.NoError(t, cosmosutil.SetGenesisChainID(tmpGenesis, "mars-1"))
+
+	// check genesis modified value
+	var actual struct {
+		Foo             string `json:"foo"`
+		ChainID         string `json:"chain_id"`
+		ConsensusParams struct {
+			ChainID string `json:"chain_id"`
+		} `json:"consensus_params"`
+	}
+	actualBytes, err := os.ReadFile(tmpGenesis)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(actualBytes, &actual))
+	require.Equal(t, "bar", actual.Foo)
+	require.Equal(t, "mars-1", actual.ChainID)
+	require.Equal(t, "mars-1", actual.ConsensusParams.ChainID)
+}
+
+func TestParseGenesisAccounts(t *testing.T) {
+	tmpDir := t.TempDir()
+	vestingGenesisPath := filepath.Join(tmpDir, "vesting_genesis.json")
+	require.NoError(t, os.WriteFile(vestingGenesisPath, []byte(`{
+  "app_state": {
+    "auth": {
+      "accounts": [
+        {
+          "@type": "/cosmos.vesting.v1beta1.DelayedVestingAccount",
+          "base_vesting_account": {
+            "base_account": {
+              "address": "spn1dd246yq6z5vzjz9gh8cff46pll75yyl8c5tt7g",
+              "pub_key": null,
+              "account_number": "3",
+              "sequence": "1"
+            },
+            "original_vesting": [],
+            "delegated_free": [],
+            "delegated_vesting": [],
+            "end_time": "1600000000"
+          }
+        }
+      ]
+    }
+  }
+}`), 0644))
+	invalidGenesisPath := filepath.Join(tmpDir, "invalid_genesis.json")
+	require.NoError(t, os.WriteFile(invalidGenesisPath, []byte(`{
+  "app_state": {
+    "auth": {
+      "accounts": [{"@type": "/foo.Account", "address": "cosmos1foo"}]
+    }
+  }
+}`), 0644))
+
+	tests := []struct {
+		name        string
+		genesisPath string
+		want        []cosmosutil.GenesisAccount
+		wantErr     bool
+	}{
+		{
+			name:        "base account",
+			genesisPath: "testdata/genesis1.json",
+			want: []cosmosutil.GenesisAccount{
+				{Address: "cosmos1dd246yq6z5vzjz9gh8cff46pll75yyl8ygndsj"},
+			},
+		},
+		{
+			name:        "vesting account",
+			genesisPath: vestingGenesisPath,
+			want: []cosmosutil.GenesisAccount{
+				{
+					Address:       "spn1dd246yq6z5vzjz9gh8cff46pll75yyl8c5tt7g",
+					AccountNumber: 3,
+					Sequence:      1,
+					Vesting:       true,
+				},
+			},
+		},
+		{
+			name:        "unknown account type",
+			genesisPath: invalidGenesisPath,
+			wantErr:     true,
+		},
+		{
+			name:        "genesis not found",
+			genesisPath: "testdata/genesis_not_found.json",
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := cosmosutil.ParseGenesisAccounts(tt.genesisPath)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMergeGenesisFiles(t *testing.T) {
+	tests := []struct {
+		name    string
+		base    string
+		overlay string
+		want    string
+		err     string
+	}{
+		{
+			name: "merge accounts and balances",
+			base: `{
+  "genesis_time": "2021-12-01T00:00:00Z",
+  "chain_id": "earth-1",
+  "app_state": {
+    "auth": {"accounts": [{"address": "cosmos1foo", "sequence": "0"}]},
+    "bank": {"balances": [{"address": "cosmos1foo", "coins": [{"denom": "stake", "amount": "100"}]}]}
+  }
+}`,
+			overlay: `{
+  "genesis_time": "2021-12-02T00:00:00Z",
+  "chain_id": "earth-1",
+  "app_state": {
+    "auth": {"accounts": [{"address": "cosmos1foo", "sequence": "0"}, {"address": "cosmos1bar", "sequence": "0"}]},
+    "bank": {"balances": [{"address": "cosmos1bar", "coins": [{"denom": "stake", "amount": "200"}]}]}
+  }
+}`,
+			want: `{
+  "genesis_time": "2021-12-01T00:00:00Z",
+  "chain_id": "earth-1",
+  "app_state": {
+    "auth": {"accounts": [{"address": "cosmos1foo", "sequence": "0"}, {"address": "cosmos1bar", "sequence": "0"}]},
+    "bank": {"balances": [
+      {"address": "cosmos1foo", "coins": [{"denom": "stake", "amount": "100"}]},
+      {"address": "cosmos1bar", "coins": [{"denom": "stake", "amount": "200"}]}
+    ]}
+  }
+}`,
+		},
+		{
+			name: "merge vesting accounts",
+			base: `{"app_state": {"auth": {"accounts": [
+  {"base_vesting_account": {"base_account": {"address": "cosmos1foo"}, "end_time": "10"}}
+]}}}`,
+			overlay: `{"app_state": {"auth": {"accounts": [
+  {"base_vesting_account": {"base_account": {"address": "cosmos1foo"}, "end_time": "10"}},
+  {"address": "cosmos1bar"}
+]}}}`,
+			want: `{"app_state": {"auth": {"accounts": [
+  {"base_vesting_account": {"base_account": {"address": "cosmos1foo"}, "end_time": "10"}},
+  {"address": "cosmos1bar"}
+]}}}`,
+		},
+		{
+			name: "merge coins and supply by denom",
+			base: `{"app_state": {"bank": {
+  "balances": [{"address": "cosmos1foo", "coins": [{"denom": "stake", "amount": "100"}]}],
+  "supply": [{"denom": "stake", "amount": "100"}]
+}}}`,
+			overlay: `{"app_state": {"bank": {
+  "balances": [{"address": "cosmos1foo", "coins": [{"denom": "stake", "amount": "100"}, {"denom": "token", "amount": "10"}]}],
+  "supply": [{"denom": "stake", "amount": "100"}, {"denom": "token", "amount": "10"}]
+}}}`,
+			want: `{"app_state": {"bank": {
+  "balances": [{"address": "cosmos1foo", "coins": [{"denom": "stake", "amount": "100"}, {"denom": "token", "amount": "10"}]}],
+  "supply": [{"denom": "stake", "amount": "200"}, {"denom": "token", "amount": "10"}]
+}}}`,
+		},
+		{
+			name: "merge accounts and validators",
+			base: `{"chain_id": "earth-1", "app_state": {
+  "auth": {"accounts": [{"address": "cosmos1foo", "account_number": "0"}, {"address": "cosmos1bar", "account_number": "0"}]},
+  "staking": {"params": {"bond_denom": "stake"}, "validators": []},
+  "genutil": {"gen_txs": []}
+}}`,
+			overlay: `{"chain_id": "earth-1", "app_state": {
+  "staking": {"params": {"bond_denom": "stake"}, "validators": [{"operator_address": "cosmosvaloper1foo", "tokens": "100"}]},
+  "genutil": {"gen_txs": [{"body": {"memo": "foo@1.2.3.4:26656"}}]},
+  "mars": {"posts": []}
+}}`,
+			want: `{"chain_id": "earth-1", "app_state": {
+  "auth": {"accounts": [{"address": "cosmos1foo", "account_number": "0"}, {"address": "cosmos1bar", "account_number": "0"}]},
+  "staking": {"params": {"bond_denom": "stake"}, "validators": [{"operator_address": "cosmosvaloper1foo", "tokens": "100"}]},
+  "genutil": {"gen_txs": [{"body": {"memo": "foo@1.2.3.4:26656"}}]},
+  "mars": {"posts": []}
+}}`,
+		},
+		{
+			name:    "merge denom metadata by base denom",
+			base:    `{"app_state": {"bank": {"denom_metadata": [{"base": "ustake", "display": "stake"}]}}}`,
+			overlay: `{"app_state": {"bank": {"denom_metadata": [{"base": "ustake", "display": "stake"}, {"base": "utoken", "display": "token"}]}}}`,
+			want:    `{"app_state": {"bank": {"denom_metadata": [{"base": "ustake", "display": "stake"}, {"base": "utoken", "display": "token"}]}}}`,
+		},
+		{
+			name:    "missing sections in base",
+			base:    `{"chain_id": "earth-1"}`,
+			overlay: `{"app_state": {"auth": {"accounts": [{"address": "cosmos1foo"}]}}}`,
+			want:    `{"chain_id": "earth-1", "app_state": {"auth": {"accounts": [{"address": "cosmos1foo"}]}}}`,
+		},
+		{
+			name:    "conflicting account",
+			base:    `{"app_state": {"auth": {"accounts": [{"address": "cosmos1foo", "sequence": "0"}]}}}`,
+			overlay: `{"app_state": {"auth": {"accounts": [{"address": "cosmos1foo", "sequence": "1"}]}}}`,
+			err:     "conflicting values for genesis field app_state.auth.accounts[cosmos1foo].sequence",
+		},
+		{
+			name:    "conflicting balance",
+			base:    `{"app_state": {"bank": {"balances": [{"address": "cosmos1foo", "coins": [{"denom": "stake", "amount": "100"}]}]}}}`,
+			overlay: `{"app_state": {"bank": {"balances": [{"address": "cosmos1foo", "coins": [{"denom": "stake", "amount": "200"}]}]}}}`,
+			err:     "conflicting values for genesis field app_state.bank.balances[cosmos1foo].coins[stake].amount",
+		},
+		{
+			name:    "sum supply",
+			base:    `{"app_state": {"bank": {"supply": [{"denom": "stake", "amount": "100"}]}}}`,
+			overlay: `{"app_state": {"bank": {"supply": [{"denom": "stake", "amount": "200"}]}}}`,
+			want:    `{"app_state": {"bank": {"supply": [{"denom": "stake", "amount": "300"}]}}}`,
+		},
+		{
+			name:    "invalid supply",
+			base:    `{"app_state": {"bank": {"supply": [{"denom": "stake", "amount": "100"}]}}}`,
+			overlay: `{"app_state": {"bank": {"supply": [{"denom": "stake", "amount": "foo"}]}}}`,
+			err:     "invalid amount for genesis field app_state.bank.supply[stake]",
+		},
+		{
+			name:    "conflicting chain ID",
+			base:    `{"chain_id": "earth-1"}`,
+			overlay: `{"chain_id": "earth-2"}`,
+			err:     "conflicting values for genesis field chain_id",
+		},
+		{
+			name:    "conflicting app state field",
+			base:    `{"app_state": {"staking": {"params": {"bond_denom": "stake"}}}}`,
+			overlay: `{"app_state": {"staking": {"params": {"bond_denom": "token"}}}}`,
+			err:     "conflicting values for genesis field app_state.staking.params.bond_denom",
+		},
+		{
+			name: "duplicated account number",
+			base: `{"app_state": {"auth": {"accounts": [{"address": "cosmos1foo", "account_number": "1"}]}}}`,
+			overlay: `{"app_state": {"auth": {"accounts": [
+  {"base_vesting_account": {"base_account": {"address": "cosmos1bar", "account_number": "1"}}}
+]}}}`,
+			err: "genesis accounts cosmos1foo and cosmos1bar have the same account number 1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			basePath := filepath.Join(tmpDir, "base.json")
+			overlayPath := filepath.Join(tmpDir, "overlay.json")
+			outPath := filepath.Join(tmpDir, "out.json")
+			require.NoError(t, os.WriteFile(basePath, []byte(tt.base), 0644))
+			require.NoError(t, os.WriteFile(overlayPath, []byte(tt.overlay), 0644))
+
+			err := cosmosutil.MergeGenesisFiles(basePath, overlayPath, outPath)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+
+			got, err := os.ReadFile(outPath)
+			require.NoError(t, err)
+			require.JSONEq(t, tt.want, string(got))
+		})
+	}
+}
+
+func TestMergeGenesisFilesSamePath(t *testing.T) {
+	genesis := `{
+  "chain_id": "earth-1",
+  "app_state": {
+    "auth": {"accounts": [{"address": "cosmos1foo"}]},
+    "bank": {
+      "balances": [{"address": "cosmos1foo", "coins": [{"denom": "stake", "amount": "100"}]}],
+      "supply": [{"denom": "stake", "amount": "100"}]
+    }
+  }
+}`
+	genesisPath := filepath.Join(t.TempDir(), "genesis.json")
+	require.NoError(t, os.WriteFile(genesisPath, []byte(genesis), 0600))
+
+	require.NoError(t, cosmosutil.MergeGenesisFiles(genesisPath, genesisPath, genesisPath))
+
+	got, err := os.ReadFile(genesisPath)
+	require.NoError(t, err)
+	require.JSONEq(t, genesis, string(got))
+
+	// the genesis file is replaced atomically and keeps its permissions
+	info, err := os.Stat(genesisPath)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	files, err := os.ReadDir(filepath.Dir(genesisPath))
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+}
@@ -4,9 +4,18 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"os"
+	"path/filepath"
 
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/std"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 var GentxFilename = "gentx.json"
@@ -19,6 +28,7 @@ type (
 		DelegatorAddress string
 		PubKey           PubKey
 		SelfDelegation   sdk.Coin
+		Memo             string
 	}
 	// StargateGentx represents the stargate gentx file
 	StargateGentx struct {
@@ -34,6 +44,7 @@ type (
 					Amount string `json:"amount"`
 				} `json:"value"`
 			} `json:"messages"`
+			Memo string `json:"memo"`
 		} `json:"body"`
 	}
 )
@@ -74,6 +85,7 @@ func ParseGentx(gentx []byte) (info GentxInfo, file []byte, err error) {
 	}
 
 	info.DelegatorAddress = stargateGentx.Body.Messages[0].DelegatorAddress
+	info.Memo = stargateGentx.Body.Memo
 	info.PubKey = []byte(stargateGentx.Body.Messages[0].PubKey.Key)
 
 	amount, ok := sdk.NewIntFromString(stargateGentx.Body.Messages[0].Value.Amount)
@@ -88,3 +100,87 @@ func ParseGentx(gentx []byte) (info GentxInfo, file []byte, err error) {
 
 	return info, gentx, nil
 }
+
+// VerifyGenesisValidatorSignatures verifies the signatures of the gentxs inside gentxDir with the chain ID of the genesis,
+// it returns the addresses of the validators whose gentx has an invalid signature
+func VerifyGenesisValidatorSignatures(genesisPath string, gentxDir string) ([]string, error) {
+	genesisFile, err := os.ReadFile(genesisPath)
+	if err != nil {
+		return nil, errors.New("cannot open genesis file: " + err.Error())
+	}
+	var genesis struct {
+		ChainID string `json:"chain_id"`
+	}
+	if err := json.Unmarshal(genesisFile, &genesis); err != nil {
+		return nil, err
+	}
+
+	gentxPaths, err := filepath.Glob(filepath.Join(gentxDir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	registry := codectypes.NewInterfaceRegistry()
+	std.RegisterInterfaces(registry)
+	stakingtypes.RegisterInterfaces(registry)
+	txConfig := authtx.NewTxConfig(codec.NewProtoCodec(registry), authtx.DefaultSignModes)
+
+	var invalidValidators []string
+	for _, gentxPath := range gentxPaths {
+		gentx, err := os.ReadFile(gentxPath)
+		if err != nil {
+			return nil, err
+		}
+		validatorAddress, valid, err := verifyGentxSignature(txConfig, genesis.ChainID, gentx)
+		if err != nil {
+			return nil, fmt.Errorf("cannot verify gentx %s: %w", gentxPath, err)
+		}
+		if !valid {
+			invalidValidators = append(invalidValidators, validatorAddress)
+		}
+	}
+
+	return invalidValidators, nil
+}
+
+// verifyGentxSignature returns the address of the validator created by the gentx and checks its signature,
+// gentxs are signed offline with the account number and sequence 0
+func verifyGentxSignature(txConfig client.TxConfig, chainID string, gentx []byte) (string, bool, error) {
+	tx, err := txConfig.TxJSONDecoder()(gentx)
+	if err != nil {
+		return "", false, err
+	}
+
+	msgs := tx.GetMsgs()
+	if len(msgs) != 1 {
+		return "", false, errors.New("add validator gentx must contain 1 message")
+	}
+	msg, ok := msgs[0].(*stakingtypes.MsgCreateValidator)
+	if !ok {
+		return "", false, fmt.Errorf("unexpected gentx message %T", msgs[0])
+	}
+
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return "", false, errors.New("the gentx cannot be verified")
+	}
+	pubKeys, err := sigTx.GetPubKeys()
+	if err != nil {
+		return "", false, err
+	}
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return "", false, err
+	}
+	if len(sigs) != 1 || len(pubKeys) != 1 || pubKeys[0] == nil {
+		return msg.ValidatorAddress, false, nil
+	}
+
+	signerData := authsigning.SignerData{
+		ChainID:       chainID,
+		AccountNumber: 0,
+		Sequence:      sigs[0].Sequence,
+	}
+	err = authsigning.VerifySignature(pubKeys[0], signerData, sigs[0].Data, txConfig.SignModeHandler(), tx)
+	return msg.ValidatorAddress, err == nil, nil
+}
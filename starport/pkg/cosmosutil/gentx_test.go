@@ -1,6 +1,10 @@
 package cosmosutil_test
 
 import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -109,6 +113,7 @@ func TestParseGentx(t *testing.T) {
 					Denom:  "stake",
 					Amount: sdk.NewInt(95000000),
 				},
+				Memo: "9b1f4adbfb0c0b513040d914bfb717303c0eaa71@192.168.0.148:26656",
 			},
 		}, {
 			name:      "parse gentx file 2",
@@ -120,6 +125,7 @@ func TestParseGentx(t *testing.T) {
 					Denom:  "stake",
 					Amount: sdk.NewInt(95000000),
 				},
+				Memo: "a412c917cb29f73cc3ad0592bbd0152fe0e690bd@192.168.0.148:26656",
 			},
 		}, {
 			name:      "parse invalid file",
@@ -172,3 +178,41 @@ func TestPubKey_Equal(t *testing.T) {
 		})
 	}
 }
+
+func TestVerifyGenesisValidatorSignatures(t *testing.T) {
+	gentx, err := os.ReadFile("testdata/gentx1.json")
+	require.NoError(t, err)
+
+	// the signed bytes of the gentx are modified by changing the moniker
+	tamperedGentx := bytes.Replace(gentx, []byte(`"moniker": "default"`), []byte(`"moniker": "foo"`), 1)
+	require.NotEqual(t, gentx, tamperedGentx)
+
+	tests := []struct {
+		name   string
+		gentxs [][]byte
+		want   []string
+	}{
+		{
+			name:   "valid signature",
+			gentxs: [][]byte{gentx},
+		},
+		{
+			name:   "invalid signature",
+			gentxs: [][]byte{gentx, tamperedGentx},
+			want:   []string{"cosmosvaloper1dd246yq6z5vzjz9gh8cff46pll75yyl8pu8cup"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gentxDir := t.TempDir()
+			for i, gentx := range tt.gentxs {
+				gentxPath := filepath.Join(gentxDir, fmt.Sprintf("gentx%d.json", i))
+				require.NoError(t, os.WriteFile(gentxPath, gentx, 0644))
+			}
+
+			got, err := cosmosutil.VerifyGenesisValidatorSignatures("testdata/genesis1.json", gentxDir)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
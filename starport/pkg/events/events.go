@@ -2,7 +2,11 @@
 // for others to consume and display to end users in meaningful ways.
 package events
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
 
 // Event represents a state.
 type Event struct {
@@ -39,26 +43,193 @@ func (e Event) Text() string {
 	return e.Description
 }
 
+// EventFilter selects the events received by a subscriber of the bus.
+type EventFilter func(Event) bool
+
+// FilterByStatus selects the events with the given status.
+func FilterByStatus(status Status) EventFilter {
+	return func(e Event) bool {
+		return e.status == status
+	}
+}
+
+// FilterByMessage selects the events whose description contains substring.
+func FilterByMessage(substring string) EventFilter {
+	return func(e Event) bool {
+		return strings.Contains(e.Description, substring)
+	}
+}
+
+const (
+	// subscriberBufferSize is the number of events a subscriber can hold, the events sent
+	// while the buffer of a subscriber is full are dropped for this subscriber.
+	subscriberBufferSize = 50
+
+	// defaultHistorySize is the default number of events kept in the history of a bus.
+	defaultHistorySize = 100
+)
+
+type subscriber struct {
+	filter EventFilter
+	ch     chan Event
+}
+
+type subscribers struct {
+	mu   sync.RWMutex
+	list []subscriber
+}
+
+// history is a ring buffer of the last events sent to a bus.
+type history struct {
+	mu     sync.Mutex
+	events []Event
+	next   int
+	full   bool
+}
+
+// add records the event in the history, replacing the oldest event once the history is full.
+func (h *history) add(e Event) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if len(h.events) == 0 {
+		return
+	}
+	h.events[h.next] = e
+	h.next = (h.next + 1) % len(h.events)
+	if h.next == 0 {
+		h.full = true
+	}
+}
+
+// last returns up to the last n recorded events, from the oldest to the most recent.
+func (h *history) last(n int) []Event {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	count := h.next
+	if h.full {
+		count = len(h.events)
+	}
+	if n > count {
+		n = count
+	}
+	if n <= 0 {
+		return nil
+	}
+
+	last := make([]Event, 0, n)
+	for i := n; i > 0; i-- {
+		last = append(last, h.events[(h.next-i+len(h.events))%len(h.events)])
+	}
+	return last
+}
+
 // Bus is a send/receive event bus.
-type Bus chan Event
+// All the events are received from Events, subscribers only receive the events selected by their filter.
+type Bus struct {
+	evchan  chan Event
+	subs    *subscribers
+	history *history
+}
 
-// NewBus creates a new event bus to send/receive events.
+// NewBus creates a new event bus to send/receive events that keeps the last 100 events in its history,
+// Send blocks until the event is received from Events.
 func NewBus() Bus {
-	return make(Bus)
+	return NewBusWithHistory(defaultHistorySize)
+}
+
+// NewBusWithHistory creates a new event bus to send/receive events that keeps the last size events in its history.
+func NewBusWithHistory(size int) Bus {
+	if size < 0 {
+		size = 0
+	}
+	return Bus{
+		evchan:  make(chan Event),
+		subs:    &subscribers{},
+		history: &history{events: make([]Event, size)},
+	}
+}
+
+// History returns up to the last n events sent to the bus, from the oldest to the most recent.
+func (b Bus) History(n int) []Event {
+	if b.history == nil {
+		return nil
+	}
+	return b.history.last(n)
+}
+
+// Events returns the channel receiving all the events sent to the bus.
+func (b Bus) Events() <-chan Event {
+	return b.evchan
+}
+
+// Subscribe returns a channel receiving the events selected by filter. events are dropped for
+// the subscribers that don't keep up with the bus, Unsubscribe must be called once the channel
+// is no longer read.
+func (b Bus) Subscribe(filter EventFilter) <-chan Event {
+	ch := make(chan Event, subscriberBufferSize)
+	if b.subs == nil {
+		close(ch)
+		return ch
+	}
+
+	b.subs.mu.Lock()
+	defer b.subs.mu.Unlock()
+	b.subs.list = append(b.subs.list, subscriber{filter: filter, ch: ch})
+	return ch
+}
+
+// Unsubscribe stops sending events to the subscriber channel ch and closes it.
+func (b Bus) Unsubscribe(ch <-chan Event) {
+	if b.subs == nil {
+		return
+	}
+
+	b.subs.mu.Lock()
+	defer b.subs.mu.Unlock()
+	for i, s := range b.subs.list {
+		if (<-chan Event)(s.ch) == ch {
+			close(s.ch)
+			b.subs.list = append(b.subs.list[:i], b.subs.list[i+1:]...)
+			return
+		}
+	}
 }
 
 // Send sends a new event to bus.
 func (b Bus) Send(e Event) {
-	if b == nil {
+	if b.evchan == nil {
 		return
 	}
-	b <- e
+	b.history.add(e)
+	b.evchan <- e
+
+	b.subs.mu.RLock()
+	defer b.subs.mu.RUnlock()
+	for _, s := range b.subs.list {
+		if s.filter != nil && !s.filter(e) {
+			continue
+		}
+		// a slow subscriber must not block the bus.
+		select {
+		case s.ch <- e:
+		default:
+		}
+	}
 }
 
 // Shutdown shutdowns event bus.
 func (b Bus) Shutdown() {
-	if b == nil {
+	if b.evchan == nil {
 		return
 	}
-	close(b)
+	close(b.evchan)
+
+	b.subs.mu.Lock()
+	defer b.subs.mu.Unlock()
+	for _, s := range b.subs.list {
+		close(s.ch)
+	}
+	b.subs.list = nil
 }
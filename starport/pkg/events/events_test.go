@@ -0,0 +1,139 @@
+package events_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/pkg/events"
+)
+
+func TestBusSubscribe(t *testing.T) {
+	bus := events.NewBus()
+	done := bus.Subscribe(events.FilterByStatus(events.StatusDone))
+	foo := bus.Subscribe(events.FilterByMessage("foo"))
+
+	sent := []events.Event{
+		events.New(events.StatusOngoing, "building foo"),
+		events.New(events.StatusDone, "foo built"),
+		events.New(events.StatusDone, "bar built"),
+	}
+
+	go func() {
+		for _, e := range sent {
+			bus.Send(e)
+		}
+		bus.Shutdown()
+	}()
+
+	var all []events.Event
+	for e := range bus.Events() {
+		all = append(all, e)
+	}
+
+	require.Equal(t, sent, all)
+	require.Equal(t, []events.Event{sent[1], sent[2]}, collect(done))
+	require.Equal(t, []events.Event{sent[0], sent[1]}, collect(foo))
+}
+
+func TestBusSendWithoutBus(t *testing.T) {
+	var bus events.Bus
+	bus.Send(events.New(events.StatusDone, "foo"))
+	bus.Shutdown()
+
+	_, ok := <-bus.Subscribe(nil)
+	require.False(t, ok)
+}
+
+func TestBusSlowSubscriber(t *testing.T) {
+	bus := events.NewBus()
+	slow := bus.Subscribe(nil)
+	all := bus.Subscribe(nil)
+
+	go func() {
+		for range bus.Events() {
+		}
+	}()
+
+	// the slow subscriber never reads its channel, sending must not block.
+	sent := make(chan struct{})
+	go func() {
+		for i := 0; i < 100; i++ {
+			bus.Send(events.New(events.StatusDone, fmt.Sprintf("event %d", i)))
+		}
+		close(sent)
+	}()
+
+	var received int
+	for range all {
+		if received++; received == 50 {
+			break
+		}
+	}
+
+	select {
+	case <-sent:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Send is blocked by a subscriber that never reads")
+	}
+
+	// the events exceeding the buffer of the slow subscriber are dropped.
+	bus.Unsubscribe(slow)
+	require.Len(t, collect(slow), 50)
+
+	bus.Shutdown()
+}
+
+func TestBusUnsubscribe(t *testing.T) {
+	bus := events.NewBus()
+	foo := bus.Subscribe(nil)
+	bar := bus.Subscribe(nil)
+
+	go func() {
+		for range bus.Events() {
+		}
+	}()
+
+	bus.Send(events.New(events.StatusDone, "foo"))
+	bus.Unsubscribe(foo)
+	bus.Send(events.New(events.StatusDone, "bar"))
+
+	// unsubscribing an unknown channel is a no-op.
+	bus.Unsubscribe(make(chan events.Event))
+	bus.Shutdown()
+
+	require.Equal(t, []events.Event{events.New(events.StatusDone, "foo")}, collect(foo))
+	require.Len(t, collect(bar), 2)
+}
+
+func collect(ch <-chan events.Event) (received []events.Event) {
+	for e := range ch {
+		received = append(received, e)
+	}
+	return received
+}
+
+func TestBusHistory(t *testing.T) {
+	bus := events.NewBusWithHistory(3)
+	go func() {
+		for range bus.Events() {
+		}
+	}()
+	defer bus.Shutdown()
+
+	require.Empty(t, bus.History(2))
+
+	var sent []events.Event
+	for _, description := range []string{"foo", "bar", "baz", "qux"} {
+		e := events.New(events.StatusDone, description)
+		bus.Send(e)
+		sent = append(sent, e)
+	}
+
+	require.Equal(t, sent[2:], bus.History(2))
+	require.Equal(t, sent[1:], bus.History(3))
+	require.Equal(t, sent[1:], bus.History(10))
+	require.Empty(t, bus.History(0))
+	require.Empty(t, events.Bus{}.History(1))
+}
@@ -3,9 +3,13 @@ package giturl
 import (
 	"errors"
 	"net/url"
+	"regexp"
 	"strings"
 )
 
+// sshURLRe matches the SCP-like syntax of Git SSH urls, e.g.: git@github.com:tendermint/starport.git.
+var sshURLRe = regexp.MustCompile(`^(?:[\w.-]+@)?([\w.-]+):/?([\w.-]+)/([\w.-]+?)(?:\.git)?/?$`)
+
 // GitURL represents a Git url.
 type GitURL struct {
 	// Host is a Git host.
@@ -41,3 +45,17 @@ func Parse(u string) (GitURL, error) {
 		Repo: sp[2],
 	}, nil
 }
+
+// ParseSSH parses a Git SSH url u using the SCP-like syntax, e.g.: git@github.com:tendermint/starport.git.
+func ParseSSH(u string) (GitURL, error) {
+	match := sshURLRe.FindStringSubmatch(u)
+	if match == nil {
+		return GitURL{}, errors.New("invalid ssh url")
+	}
+
+	return GitURL{
+		Host: match[1],
+		User: match[2],
+		Repo: match[3],
+	}, nil
+}
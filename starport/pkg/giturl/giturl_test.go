@@ -14,3 +14,20 @@ func TestParse(t *testing.T) {
 	require.Equal(t, "starport", parsed.Repo)
 	require.Equal(t, "tendermint/starport", parsed.UserAndRepo())
 }
+
+func TestParseSSH(t *testing.T) {
+	parsed, err := ParseSSH("git@github.com:tendermint/starport.git")
+	require.NoError(t, err)
+	require.Equal(t, "github.com", parsed.Host)
+	require.Equal(t, "tendermint", parsed.User)
+	require.Equal(t, "starport", parsed.Repo)
+	require.Equal(t, "tendermint/starport", parsed.UserAndRepo())
+
+	parsed, err = ParseSSH("gitlab.com:tendermint/starport")
+	require.NoError(t, err)
+	require.Equal(t, "gitlab.com", parsed.Host)
+	require.Equal(t, "tendermint/starport", parsed.UserAndRepo())
+
+	_, err = ParseSSH("https://github.com/tendermint/starport")
+	require.Error(t, err)
+}
@@ -14,10 +14,14 @@ const (
 
 	// GOPATH is the env var for GOPATH.
 	GOPATH = "GOPATH"
+
+	// GOMODCACHE is the env var for GOMODCACHE.
+	GOMODCACHE = "GOMODCACHE"
 )
 
 const (
-	binDir = "bin"
+	binDir      = "bin"
+	modCacheDir = "pkg/mod"
 )
 
 // Bin returns the path of where Go binaries are installed.
@@ -31,6 +35,18 @@ func Bin() string {
 	return filepath.Join(build.Default.GOPATH, binDir)
 }
 
+// ModCache returns the path of the Go module cache.
+func ModCache() string {
+	if modCachePath := os.Getenv(GOMODCACHE); modCachePath != "" {
+		return modCachePath
+	}
+	goPath := os.Getenv(GOPATH)
+	if goPath == "" {
+		goPath = build.Default.GOPATH
+	}
+	return filepath.Join(filepath.SplitList(goPath)[0], modCacheDir)
+}
+
 // Path returns $PATH with correct go bin configuration set.
 func Path() string {
 	return os.ExpandEnv(fmt.Sprintf("$PATH:%s", Bin()))
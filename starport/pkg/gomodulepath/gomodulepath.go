@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"go/parser"
 	"go/token"
+	"io/fs"
 	"path/filepath"
 	"regexp"
 	"strings"
@@ -72,6 +73,35 @@ func Find(path string) (parsed Path, appPath string, err error) {
 	return Path{}, "", errors.Wrap(gomodule.ErrGoModNotFound, "could not locate your app's root dir")
 }
 
+// FindAll searches the Go modules in root and its subdirectories, a directory containing
+// a go.mod is a module root and its subdirectories are not searched.
+func FindAll(root string) ([]Path, error) {
+	var paths []Path
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+
+		parsed, err := ParseAt(path)
+		if errors.Is(err, gomodule.ErrGoModNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		paths = append(paths, parsed)
+
+		return filepath.SkipDir
+	})
+	if err != nil {
+		return nil, err
+	}
+	return paths, nil
+}
+
 func validateModulePath(path string) error {
 	if err := module.CheckPath(path); err != nil {
 		return fmt.Errorf("app name is an invalid go module name: %w", err)
@@ -3,6 +3,8 @@ package gomodulepath
 import (
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -70,3 +72,31 @@ func TestParse(t *testing.T) {
 		})
 	}
 }
+
+func TestFindAll(t *testing.T) {
+	root := t.TempDir()
+	for dir, modulePath := range map[string]string{
+		"chain":          "github.com/foo/chain",
+		"chain/tools":    "github.com/foo/chain/tools",
+		"relayer":        "github.com/foo/relayer",
+		"docs/examples/": "",
+	} {
+		path := filepath.Join(root, dir)
+		require.NoError(t, os.MkdirAll(path, 0755))
+		if modulePath != "" {
+			gomod := fmt.Sprintf("module %s\n", modulePath)
+			require.NoError(t, os.WriteFile(filepath.Join(path, "go.mod"), []byte(gomod), 0644))
+		}
+	}
+
+	paths, err := FindAll(root)
+	require.NoError(t, err)
+	require.Equal(t, []Path{
+		{RawPath: "github.com/foo/chain", Root: "chain", Package: "chain"},
+		{RawPath: "github.com/foo/relayer", Root: "relayer", Package: "relayer"},
+	}, paths)
+
+	paths, err = FindAll(filepath.Join(root, "docs"))
+	require.NoError(t, err)
+	require.Empty(t, paths)
+}
@@ -0,0 +1,99 @@
+package localfs
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// WatchDir watches dir and its subdirectories and sends the path of the files matching
+// the glob pattern to the returned channel when they are created or modified.
+// Hidden directories are not watched, the channel is closed when ctx is cancelled.
+func WatchDir(ctx context.Context, dir, pattern string) (<-chan string, error) {
+	// check the pattern is valid before starting to watch
+	if _, err := filepath.Match(pattern, ""); err != nil {
+		return nil, err
+	}
+
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	if err := addWatchDirs(w, dir); err != nil {
+		w.Close()
+		return nil, err
+	}
+
+	changes := make(chan string)
+	go func() {
+		defer close(changes)
+		defer w.Close()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case e, ok := <-w.Events:
+				if !ok {
+					return
+				}
+
+				if e.Op&(fsnotify.Create|fsnotify.Write) == 0 {
+					continue
+				}
+
+				paths := []string{e.Name}
+
+				// watch the directories created after the watch started, the files
+				// created in them before they are watched are notified as well.
+				if info, err := os.Stat(e.Name); err == nil && info.IsDir() {
+					if e.Op&fsnotify.Create == 0 {
+						continue
+					}
+					if err := addWatchDirs(w, e.Name); err != nil {
+						continue
+					}
+					if paths, err = Search(e.Name, pattern); err != nil {
+						continue
+					}
+				}
+
+				for _, path := range paths {
+					if matched, _ := filepath.Match(pattern, filepath.Base(path)); !matched {
+						continue
+					}
+					select {
+					case changes <- path:
+					case <-ctx.Done():
+						return
+					}
+				}
+			case _, ok := <-w.Errors:
+				if !ok {
+					return
+				}
+			}
+		}
+	}()
+
+	return changes, nil
+}
+
+// addWatchDirs adds dir and its subdirectories to the watcher, skipping hidden directories.
+func addWatchDirs(w *fsnotify.Watcher, dir string) error {
+	return filepath.Walk(dir, func(path string, f os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !f.IsDir() {
+			return nil
+		}
+		if path != dir && strings.HasPrefix(f.Name(), ".") {
+			return filepath.SkipDir
+		}
+		return w.Add(path)
+	})
+}
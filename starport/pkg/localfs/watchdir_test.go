@@ -0,0 +1,50 @@
+package localfs
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatchDir(t *testing.T) {
+	dir := t.TempDir()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	changes, err := WatchDir(ctx, dir, "*.proto")
+	require.NoError(t, err)
+
+	waitChange := func() string {
+		t.Helper()
+		select {
+		case path := <-changes:
+			return path
+		case <-time.After(5 * time.Second):
+			t.Fatal("no file change notified")
+			return ""
+		}
+	}
+
+	// files not matching the pattern are not notified
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "foo.go"), []byte("foo"), 0644))
+	protoPath := filepath.Join(dir, "foo.proto")
+	require.NoError(t, os.WriteFile(protoPath, []byte("foo"), 0644))
+	require.Equal(t, protoPath, waitChange())
+
+	// files of the new directories are notified
+	subDir := filepath.Join(dir, "bar")
+	require.NoError(t, os.Mkdir(subDir, 0755))
+	subProtoPath := filepath.Join(subDir, "bar.proto")
+	require.NoError(t, os.WriteFile(subProtoPath, []byte("bar"), 0644))
+	for path := waitChange(); path != subProtoPath; path = waitChange() {
+		require.Equal(t, protoPath, path)
+	}
+
+	cancel()
+	for range changes {
+	}
+}
@@ -9,8 +9,18 @@ import (
 	"github.com/tendermint/starport/starport/pkg/nodetime"
 )
 
+// DefaultFlags are the flags used by Starport to generate the client code, they can be used as
+// a base by the callers of GenerateWithOptions. -1 as module name index removes the route namespace.
+var DefaultFlags = []string{"--module-name-index", "-1"}
+
 // Generate generates client code and TS types to outPath from an OpenAPI spec that resides at specPath.
 func Generate(ctx context.Context, outPath, specPath, moduleNameIndex string) error {
+	return GenerateWithOptions(ctx, outPath, specPath, "--module-name-index", moduleNameIndex)
+}
+
+// GenerateWithOptions generates client code and TS types to outPath from an OpenAPI spec that resides
+// at specPath, opts are appended to the flags of the swagger-typescript-api command.
+func GenerateWithOptions(ctx context.Context, outPath, specPath string, opts ...string) error {
 	command, cleanup, err := nodetime.Command(nodetime.CommandSTA)
 	if err != nil {
 		return err
@@ -22,8 +32,6 @@ func Generate(ctx context.Context, outPath, specPath, moduleNameIndex string) er
 
 	// command constructs the sta command.
 	command = append(command, []string{
-		"--module-name-index",
-		moduleNameIndex,
 		"-p",
 		specPath,
 		"-o",
@@ -31,6 +39,7 @@ func Generate(ctx context.Context, outPath, specPath, moduleNameIndex string) er
 		"-n",
 		file,
 	}...)
+	command = append(command, opts...)
 
 	// execute the command.
 	return exec.Exec(ctx, command, exec.IncludeStdLogsToError())
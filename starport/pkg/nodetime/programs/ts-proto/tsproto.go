@@ -6,12 +6,28 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 
 	"github.com/tendermint/starport/starport/pkg/nodetime"
 )
 
 const pluginName = "protoc-gen-ts_proto"
 
+var (
+	// cached is the binary extracted by BinaryPathCached, once is replaced when the binary is cleaned up
+	// so the binary is extracted again by the next call.
+	cached = struct {
+		sync.Mutex
+		once    *sync.Once
+		path    string
+		cleanup func()
+		err     error
+	}{once: &sync.Once{}}
+
+	// extractBinary extracts the binary of the plugin, it can be replaced by tests.
+	extractBinary = BinaryPath
+)
+
 // BinaryPath returns the path to the binary of the ts-proto plugin so it can be passed to
 // protoc via --plugin option.
 //
@@ -38,3 +54,45 @@ func BinaryPath() (path string, cleanup func(), err error) {
 
 	return
 }
+
+// BinaryPathCached returns the path to the binary of the ts-proto plugin like BinaryPath but the binary
+// is only extracted on the first call, the next calls return the same path until cleanup is called.
+//
+// cleanup removes the binary for all the callers so it should be called once the plugin is not used
+// by the process anymore, the next call extracts the binary again.
+func BinaryPathCached() (path string, cleanup func(), err error) {
+	cached.Lock()
+	defer cached.Unlock()
+
+	once := cached.once
+	once.Do(func() {
+		var binaryCleanup func()
+		cached.path, binaryCleanup, cached.err = extractBinary()
+		if cached.err != nil {
+			return
+		}
+
+		var cleanupOnce sync.Once
+		path := cached.path
+		cached.cleanup = func() {
+			cleanupOnce.Do(func() {
+				cached.Lock()
+				if cached.once == once {
+					cached.once = &sync.Once{}
+				}
+				cached.Unlock()
+
+				binaryCleanup()
+				os.Remove(path)
+			})
+		}
+	})
+
+	if cached.err != nil {
+		// the extraction is retried by the next call
+		err = cached.err
+		cached.once = &sync.Once{}
+		return "", nil, err
+	}
+	return cached.path, cached.cleanup, nil
+}
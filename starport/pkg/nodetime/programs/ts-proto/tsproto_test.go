@@ -0,0 +1,91 @@
+package tsproto
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeExtractBinary replaces the extraction of the binary by the creation of an empty file
+// and returns the number of extractions and cleanups.
+func fakeExtractBinary(t *testing.T) (extractions, cleanups *int) {
+	extractions, cleanups = new(int), new(int)
+	dir := t.TempDir()
+
+	extractBinary = func() (string, func(), error) {
+		*extractions++
+		path := filepath.Join(dir, pluginName)
+		return path, func() { *cleanups++ }, os.WriteFile(path, nil, 0755)
+	}
+	t.Cleanup(func() { extractBinary = BinaryPath })
+
+	return extractions, cleanups
+}
+
+func TestBinaryPathCached(t *testing.T) {
+	extractions, cleanups := fakeExtractBinary(t)
+
+	path, cleanup, err := BinaryPathCached()
+	require.NoError(t, err)
+	require.FileExists(t, path)
+
+	// the concurrent callers share the extracted binary.
+	var (
+		wg          sync.WaitGroup
+		cachedPaths = make([]string, 10)
+		errs        = make([]error, 10)
+	)
+	for i := range cachedPaths {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			cachedPaths[i], _, errs[i] = BinaryPathCached()
+		}(i)
+	}
+	wg.Wait()
+	for i := range cachedPaths {
+		require.NoError(t, errs[i])
+		require.Equal(t, path, cachedPaths[i])
+	}
+	require.Equal(t, 1, *extractions)
+
+	// the binary is removed for all the callers.
+	_, cachedCleanup, err := BinaryPathCached()
+	require.NoError(t, err)
+	cleanup()
+	cachedCleanup()
+	require.NoFileExists(t, path)
+	require.Equal(t, 1, *cleanups)
+
+	// the binary is extracted again after a cleanup.
+	path, cleanup, err = BinaryPathCached()
+	require.NoError(t, err)
+	require.FileExists(t, path)
+	require.Equal(t, 2, *extractions)
+
+	cleanup()
+	require.Equal(t, 2, *cleanups)
+}
+
+func TestBinaryPathCachedError(t *testing.T) {
+	extractions, _ := fakeExtractBinary(t)
+	fakeExtract := extractBinary
+
+	extractBinary = func() (string, func(), error) {
+		return "", nil, errors.New("extraction failed")
+	}
+	_, _, err := BinaryPathCached()
+	require.EqualError(t, err, "extraction failed")
+
+	// the failed extraction is not cached.
+	extractBinary = fakeExtract
+	path, cleanup, err := BinaryPathCached()
+	require.NoError(t, err)
+	defer cleanup()
+	require.FileExists(t, path)
+	require.Equal(t, 1, *extractions)
+}
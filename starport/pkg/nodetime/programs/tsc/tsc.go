@@ -37,13 +37,14 @@ type Config struct {
 
 // CompilerOptions section of tsconfig.json.
 type CompilerOptions struct {
-	BaseURL          string   `json:"baseUrl"`
-	ModuleResolution string   `json:"moduleResolution"`
-	Target           string   `json:"target"`
-	Module           string   `json:"module"`
-	TypeRoots        []string `json:"typeRoots"`
-	Declaration      bool     `json:"declaration"`
-	SkipLibCheck     bool     `json:"skipLibCheck"`
+	BaseURL              string   `json:"baseUrl"`
+	ModuleResolution     string   `json:"moduleResolution"`
+	Target               string   `json:"target"`
+	Module               string   `json:"module"`
+	TypeRoots            []string `json:"typeRoots"`
+	Declaration          bool     `json:"declaration"`
+	EmitDeclarationsOnly bool     `json:"emitDeclarationOnly"`
+	SkipLibCheck         bool     `json:"skipLibCheck"`
 }
 
 // Generate transpiles TS into JS by given TS config.
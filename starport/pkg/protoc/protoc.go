@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/tendermint/starport/starport/pkg/cmdrunner/exec"
 	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
@@ -70,6 +72,55 @@ func Command() (command Cmd, cleanup func(), err error) {
 	return command, cleanup, nil
 }
 
+// pluginPrefix is the prefix of the protoc plugin binaries
+const pluginPrefix = "protoc-gen-"
+
+// DefaultProtocTimeout is the default timeout of the code generations run with GenerateWithTimeout.
+var DefaultProtocTimeout = 2 * time.Minute
+
+// ListPlugins checks protoc can be run and returns the name of the protoc plugins
+// found in the PATH, the names are returned without the protoc-gen- prefix.
+func ListPlugins() ([]string, error) {
+	cmd, cleanup, err := Command()
+	if err != nil {
+		return nil, err
+	}
+	defer cleanup()
+
+	command := append(cmd.Command, "--version")
+	if err := exec.Exec(context.Background(), command, exec.IncludeStdLogsToError()); err != nil {
+		return nil, err
+	}
+
+	found := make(map[string]bool)
+	for _, dir := range filepath.SplitList(os.Getenv("PATH")) {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			// PATH can contain directories that don't exist
+			continue
+		}
+		for _, entry := range entries {
+			name := entry.Name()
+			if entry.IsDir() || !strings.HasPrefix(name, pluginPrefix) {
+				continue
+			}
+			info, err := entry.Info()
+			if err != nil || info.Mode()&0111 == 0 {
+				continue
+			}
+			found[strings.TrimPrefix(name, pluginPrefix)] = true
+		}
+	}
+
+	plugins := make([]string, 0, len(found))
+	for plugin := range found {
+		plugins = append(plugins, plugin)
+	}
+	sort.Strings(plugins)
+
+	return plugins, nil
+}
+
 // Generate generates code into outDir from protoPath and its includePaths by using plugins provided with protocOuts.
 func Generate(ctx context.Context, outDir, protoPath string, includePaths, protocOuts []string, options ...Option) error {
 	c := configs{}
@@ -127,6 +178,27 @@ func Generate(ctx context.Context, outDir, protoPath string, includePaths, proto
 	return nil
 }
 
+// GenerateWithTimeout generates code like Generate but cancels protoc when it doesn't complete within timeout,
+// DefaultProtocTimeout is used when timeout is not positive.
+func GenerateWithTimeout(
+	ctx context.Context,
+	timeout time.Duration,
+	outDir,
+	protoPath string,
+	includePaths,
+	protocOuts []string,
+	options ...Option,
+) error {
+	if timeout <= 0 {
+		timeout = DefaultProtocTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	return Generate(ctx, outDir, protoPath, includePaths, protocOuts, options...)
+}
+
 // discoverFiles discovers .proto files to do code generation for. .proto files of the app
 // (everything under protoPath) will always be a part of the discovered files.
 //
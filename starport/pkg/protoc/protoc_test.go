@@ -0,0 +1,31 @@
+package protoc_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/pkg/protoc"
+)
+
+func TestListPlugins(t *testing.T) {
+	dir, otherDir := t.TempDir(), t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "protoc-gen-foo"), nil, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "protoc-gen-bar"), nil, 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "foo"), nil, 0755))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "protoc-gen-baz"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(otherDir, "protoc-gen-foo"), nil, 0755))
+
+	path := os.Getenv("PATH")
+	require.NoError(t, os.Setenv("PATH", strings.Join(
+		[]string{dir, filepath.Join(dir, "missing"), otherDir},
+		string(os.PathListSeparator),
+	)))
+	t.Cleanup(func() { os.Setenv("PATH", path) })
+
+	plugins, err := protoc.ListPlugins()
+	require.NoError(t, err)
+	require.Equal(t, []string{"foo"}, plugins)
+}
@@ -8,14 +8,29 @@ import (
 	"io"
 	"net/http"
 	"strconv"
+	"strings"
 )
 
 const (
 	endpointNetInfo = "/net_info"
 	endpointGenesis = "/genesis"
 	endpointStatus  = "/status"
+
+	endpointConsensusState = "/consensus_state"
 )
 
+// roundStepNames are the names of the consensus round steps indexed by their value.
+var roundStepNames = map[int]string{
+	1: "NewHeight",
+	2: "NewRound",
+	3: "Propose",
+	4: "Prevote",
+	5: "PrevoteWait",
+	6: "Precommit",
+	7: "PrecommitWait",
+	8: "Commit",
+}
+
 // Client is a Tendermint RPC client.
 type Client struct {
 	addr string
@@ -29,6 +44,15 @@ func New(addr string) Client {
 // NetInfo represents Network Info.
 type NetInfo struct {
 	ConnectedPeers int
+	Peers          []Peer
+}
+
+// Peer represents a peer connected to the node.
+type Peer struct {
+	NodeID     string
+	Moniker    string
+	RemoteIP   string
+	ListenAddr string
 }
 
 func (c Client) url(endpoint string) string {
@@ -47,9 +71,21 @@ func (c Client) GetNetInfo(ctx context.Context) (NetInfo, error) {
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode != http.StatusOK {
+		return NetInfo{}, fmt.Errorf("%d", resp.StatusCode)
+	}
+
 	var res struct {
 		Result struct {
-			Peers string `json:"n_peers"`
+			Peers     string `json:"n_peers"`
+			PeersInfo []struct {
+				NodeInfo struct {
+					ID         string `json:"id"`
+					Moniker    string `json:"moniker"`
+					ListenAddr string `json:"listen_addr"`
+				} `json:"node_info"`
+				RemoteIP string `json:"remote_ip"`
+			} `json:"peers"`
 		} `json:"result"`
 	}
 	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
@@ -61,9 +97,19 @@ func (c Client) GetNetInfo(ctx context.Context) (NetInfo, error) {
 		return NetInfo{}, err
 	}
 
-	return NetInfo{
+	info := NetInfo{
 		ConnectedPeers: int(peers),
-	}, nil
+	}
+	for _, peer := range res.Result.PeersInfo {
+		info.Peers = append(info.Peers, Peer{
+			NodeID:     peer.NodeInfo.ID,
+			Moniker:    peer.NodeInfo.Moniker,
+			RemoteIP:   peer.RemoteIP,
+			ListenAddr: peer.NodeInfo.ListenAddr,
+		})
+	}
+
+	return info, nil
 }
 
 // Genesis represents Genesis.
@@ -101,6 +147,108 @@ func (c Client) GetGenesis(ctx context.Context) (Genesis, error) {
 	return out.Result.Genesis, nil
 }
 
+// GetLatestBlockHeight retrieves the height of the latest block committed by the node.
+func (c Client) GetLatestBlockHeight(ctx context.Context) (int64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url(endpointStatus), nil)
+	if err != nil {
+		return 0, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("%d", resp.StatusCode)
+	}
+
+	var out struct {
+		Result struct {
+			SyncInfo struct {
+				LatestBlockHeight string `json:"latest_block_height"`
+			} `json:"sync_info"`
+		} `json:"result"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseInt(out.Result.SyncInfo.LatestBlockHeight, 10, 64)
+}
+
+// ConsensusState represents the state of the current consensus round.
+type ConsensusState struct {
+	Height   int64
+	Round    int32
+	Step     string
+	Proposer string
+}
+
+// GetConsensusState retrieves the state of the current consensus round.
+func (c Client) GetConsensusState(ctx context.Context) (ConsensusState, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url(endpointConsensusState), nil)
+	if err != nil {
+		return ConsensusState{}, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return ConsensusState{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return ConsensusState{}, fmt.Errorf("%d", resp.StatusCode)
+	}
+
+	var out struct {
+		Result struct {
+			RoundState struct {
+				HeightRoundStep string `json:"height/round/step"`
+				Proposer        struct {
+					Address string `json:"address"`
+				} `json:"proposer"`
+			} `json:"round_state"`
+		} `json:"result"`
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return ConsensusState{}, err
+	}
+
+	// the round is formatted as height/round/step.
+	hrs := strings.Split(out.Result.RoundState.HeightRoundStep, "/")
+	if len(hrs) != 3 {
+		return ConsensusState{}, fmt.Errorf("invalid consensus round %q", out.Result.RoundState.HeightRoundStep)
+	}
+	height, err := strconv.ParseInt(hrs[0], 10, 64)
+	if err != nil {
+		return ConsensusState{}, err
+	}
+	round, err := strconv.ParseInt(hrs[1], 10, 32)
+	if err != nil {
+		return ConsensusState{}, err
+	}
+	step, err := strconv.Atoi(hrs[2])
+	if err != nil {
+		return ConsensusState{}, err
+	}
+	stepName, ok := roundStepNames[step]
+	if !ok {
+		stepName = hrs[2]
+	}
+
+	return ConsensusState{
+		Height:   height,
+		Round:    int32(round),
+		Step:     stepName,
+		Proposer: out.Result.RoundState.Proposer.Address,
+	}, nil
+}
+
 // NodeInfo holds node info.
 type NodeInfo struct {
 	Network string
@@ -0,0 +1,241 @@
+package tendermintrpc_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/pkg/tendermintrpc"
+)
+
+const rpcErrorResponse = `{
+  "jsonrpc": "2.0",
+  "id": -1,
+  "error": {"code": -32603, "message": "Internal error", "data": "node is not running"}
+}`
+
+// newTestServer returns a Tendermint RPC server responding to the endpoint with the status and body.
+func newTestServer(t *testing.T, endpoint string, status int, body string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, endpoint, r.URL.Path)
+		w.WriteHeader(status)
+		fmt.Fprint(w, body)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestGetNetInfo(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		want   tendermintrpc.NetInfo
+		err    bool
+	}{
+		{
+			name:   "peers",
+			status: http.StatusOK,
+			body: `{
+  "jsonrpc": "2.0",
+  "id": -1,
+  "result": {
+    "listening": true,
+    "listeners": ["Listener(@)"],
+    "n_peers": "1",
+    "peers": [
+      {
+        "node_info": {
+          "protocol_version": {"p2p": "8", "block": "11", "app": "0"},
+          "id": "e0e4ad8bd3c0cd4fe3c4d8d36a0e0a2e8b1f5a2d",
+          "listen_addr": "tcp://0.0.0.0:26656",
+          "network": "mars-1",
+          "version": "0.34.14",
+          "moniker": "foo"
+        },
+        "is_outbound": true,
+        "remote_ip": "10.0.0.1"
+      }
+    ]
+  }
+}`,
+			want: tendermintrpc.NetInfo{
+				ConnectedPeers: 1,
+				Peers: []tendermintrpc.Peer{
+					{
+						NodeID:     "e0e4ad8bd3c0cd4fe3c4d8d36a0e0a2e8b1f5a2d",
+						Moniker:    "foo",
+						RemoteIP:   "10.0.0.1",
+						ListenAddr: "tcp://0.0.0.0:26656",
+					},
+				},
+			},
+		},
+		{
+			name:   "no peers",
+			status: http.StatusOK,
+			body: `{
+  "jsonrpc": "2.0",
+  "id": -1,
+  "result": {"listening": true, "listeners": ["Listener(@)"], "n_peers": "0", "peers": []}
+}`,
+			want: tendermintrpc.NetInfo{},
+		},
+		{
+			name:   "error response",
+			status: http.StatusInternalServerError,
+			body:   rpcErrorResponse,
+			err:    true,
+		},
+		{
+			name:   "invalid peer count",
+			status: http.StatusOK,
+			body:   `{"jsonrpc": "2.0", "id": -1, "result": {"n_peers": "foo", "peers": []}}`,
+			err:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newTestServer(t, "/net_info", tt.status, tt.body)
+
+			got, err := tendermintrpc.New(server.URL).GetNetInfo(context.Background())
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGetLatestBlockHeight(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		want   int64
+		err    bool
+	}{
+		{
+			name:   "height",
+			status: http.StatusOK,
+			body: `{
+  "jsonrpc": "2.0",
+  "id": -1,
+  "result": {
+    "node_info": {"network": "mars-1", "moniker": "foo"},
+    "sync_info": {
+      "latest_block_hash": "8D9A3E0C2F8B6D4A1E7C5B3A9F0D2E4C6B8A1D3F5E7C9B0A2D4F6E8C1B3A5D7",
+      "latest_block_height": "1024",
+      "latest_block_time": "2021-12-10T09:41:28.123456Z",
+      "catching_up": false
+    }
+  }
+}`,
+			want: 1024,
+		},
+		{
+			name:   "error response",
+			status: http.StatusInternalServerError,
+			body:   rpcErrorResponse,
+			err:    true,
+		},
+		{
+			name:   "invalid height",
+			status: http.StatusOK,
+			body:   `{"jsonrpc": "2.0", "id": -1, "result": {"sync_info": {"latest_block_height": 1024}}}`,
+			err:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newTestServer(t, "/status", tt.status, tt.body)
+
+			got, err := tendermintrpc.New(server.URL).GetLatestBlockHeight(context.Background())
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGetConsensusState(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		want   tendermintrpc.ConsensusState
+		err    bool
+	}{
+		{
+			name:   "consensus state",
+			status: http.StatusOK,
+			body: `{
+  "jsonrpc": "2.0",
+  "id": -1,
+  "result": {
+    "round_state": {
+      "height/round/step": "1025/0/4",
+      "start_time": "2021-12-10T09:41:29.123456Z",
+      "proposal_block_hash": "",
+      "locked_block_hash": "",
+      "valid_block_hash": "",
+      "height_vote_set": [],
+      "proposer": {
+        "address": "6D6A1E313E407B4F0A8C3A2BB0F5A2E7C0D5E3F1",
+        "index": "0"
+      }
+    }
+  }
+}`,
+			want: tendermintrpc.ConsensusState{
+				Height:   1025,
+				Round:    0,
+				Step:     "Prevote",
+				Proposer: "6D6A1E313E407B4F0A8C3A2BB0F5A2E7C0D5E3F1",
+			},
+		},
+		{
+			name:   "unknown step",
+			status: http.StatusOK,
+			body:   `{"jsonrpc": "2.0", "id": -1, "result": {"round_state": {"height/round/step": "1/2/42", "proposer": {}}}}`,
+			want: tendermintrpc.ConsensusState{
+				Height: 1,
+				Round:  2,
+				Step:   "42",
+			},
+		},
+		{
+			name:   "error response",
+			status: http.StatusInternalServerError,
+			body:   rpcErrorResponse,
+			err:    true,
+		},
+		{
+			name:   "invalid round",
+			status: http.StatusOK,
+			body:   `{"jsonrpc": "2.0", "id": -1, "result": {"round_state": {"height/round/step": "1025/0"}}}`,
+			err:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newTestServer(t, "/consensus_state", tt.status, tt.body)
+
+			got, err := tendermintrpc.New(server.URL).GetConsensusState(context.Background())
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -3,8 +3,13 @@ package xstrings
 import (
 	"strings"
 	"unicode"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 )
 
+// ellipsis replaces the characters removed from truncated strings
+const ellipsis = "…"
+
 // AllOrSomeFilter filters elems out from the list as they  present in filterList and
 // returns the remaning ones.
 // if filterList is empty, all elems from list returned.
@@ -66,3 +71,31 @@ func NoNumberPrefix(s string) string {
 	}
 	return s
 }
+
+// TruncateMiddle truncates s to fit within maxLen runes by replacing its middle with an ellipsis,
+// the same number of runes is kept from the start and the end of s.
+// s is returned unchanged if it already fits within maxLen runes.
+func TruncateMiddle(s string, maxLen int) string {
+	runes := []rune(s)
+	if len(runes) <= maxLen {
+		return s
+	}
+	if maxLen <= 0 {
+		return ""
+	}
+
+	keep := (maxLen - 1) / 2
+	return string(runes[:keep]) + ellipsis + string(runes[len(runes)-keep:])
+}
+
+// ValidateBech32 checks if s is a valid bech32 string, e.g. an account address,
+// the decoding error is returned when it is not.
+func ValidateBech32(s string) error {
+	_, _, err := bech32.DecodeAndConvert(s)
+	return err
+}
+
+// IsValidBech32 checks if s is a valid bech32 string, e.g. an account address.
+func IsValidBech32(s string) bool {
+	return ValidateBech32(s) == nil
+}
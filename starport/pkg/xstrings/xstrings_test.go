@@ -16,3 +16,28 @@ func TestNoNumberPrefix(t *testing.T) {
 	require.Equal(t, "_0foo", xstrings.NoNumberPrefix("0foo"))
 	require.Equal(t, "_999foo", xstrings.NoNumberPrefix("999foo"))
 }
+
+func TestTruncateMiddle(t *testing.T) {
+	address := "spn1sgphx4vxt63xhvgp9wpewajyxeqt04twfj7gcc"
+	require.Equal(t, address, xstrings.TruncateMiddle(address, len(address)))
+	require.Equal(t, "spn1sgph…twfj7gcc", xstrings.TruncateMiddle(address, 17))
+	require.Equal(t, "spn1sgp…wfj7gcc", xstrings.TruncateMiddle(address, 16))
+	require.Equal(t, "fo…ar", xstrings.TruncateMiddle("foo€€bar", 5))
+	require.Equal(t, "…", xstrings.TruncateMiddle("foobar", 1))
+	require.Equal(t, "", xstrings.TruncateMiddle("foobar", 0))
+}
+
+func TestIsValidBech32(t *testing.T) {
+	require.True(t, xstrings.IsValidBech32("spn1dd246yq6z5vzjz9gh8cff46pll75yyl8c5tt7g"))
+	require.True(t, xstrings.IsValidBech32("cosmos1dd246yq6z5vzjz9gh8cff46pll75yyl8ygndsj"))
+	require.False(t, xstrings.IsValidBech32("spn1dd246yq6z5vzjz9gh8cff46pll75yyl8c5tt7h"))
+	require.False(t, xstrings.IsValidBech32("foo"))
+	require.False(t, xstrings.IsValidBech32(""))
+}
+
+func TestValidateBech32(t *testing.T) {
+	require.NoError(t, xstrings.ValidateBech32("spn1dd246yq6z5vzjz9gh8cff46pll75yyl8c5tt7g"))
+	require.EqualError(t, xstrings.ValidateBech32("spn1dd246yq6z5vzjz9gh8cff46pll75yyl8c5tt7h"),
+		"decoding bech32 failed: invalid checksum (expected c5tt7g got c5tt7h)")
+	require.EqualError(t, xstrings.ValidateBech32("foo"), "decoding bech32 failed: invalid bech32 string length 3")
+}
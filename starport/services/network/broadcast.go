@@ -0,0 +1,47 @@
+package network
+
+import (
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/pkg/errors"
+	"github.com/tendermint/starport/starport/pkg/cosmosclient"
+	"github.com/tendermint/starport/starport/pkg/events"
+)
+
+// broadcastRetryInterval is the delay before the first retry of a transaction, it doubles after each retry.
+const broadcastRetryInterval = 500 * time.Millisecond
+
+// BroadcastWithRetry broadcasts the messages in a transaction and broadcasts them again, up to maxRetries times,
+// when the transaction fails because the sequence of the account changed while it was being created.
+func (n Network) BroadcastWithRetry(accountName string, maxRetries int, msgs ...sdk.Msg) (cosmosclient.Response, error) {
+	interval := broadcastRetryInterval
+	for retry := 0; ; retry++ {
+		// the account sequence is fetched from SPN for each broadcast
+		res, err := n.cosmos.BroadcastTx(accountName, msgs...)
+		if err == nil || retry >= maxRetries || !isWrongSequenceError(res, err) {
+			return res, err
+		}
+
+		n.ev.Send(events.New(events.StatusOngoing, "Account sequence mismatch, retrying the transaction..."))
+		time.Sleep(interval)
+		interval *= 2
+	}
+}
+
+// isWrongSequenceError checks if a broadcast failed because of an account sequence mismatch.
+func isWrongSequenceError(res cosmosclient.Response, err error) bool {
+	if errors.Is(err, sdkerrors.ErrWrongSequence) {
+		return true
+	}
+	if res.TxResponse != nil &&
+		res.TxResponse.Codespace == sdkerrors.RootCodespace &&
+		res.TxResponse.Code == sdkerrors.ErrWrongSequence.ABCICode() {
+		return true
+	}
+
+	// the error of a transaction simulation is only available as a message
+	return strings.Contains(err.Error(), sdkerrors.ErrWrongSequence.Error())
+}
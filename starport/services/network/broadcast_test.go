@@ -0,0 +1,63 @@
+package network
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/pkg/cosmosclient"
+)
+
+func TestIsWrongSequenceError(t *testing.T) {
+	tests := []struct {
+		name string
+		res  cosmosclient.Response
+		err  error
+		want bool
+	}{
+		{
+			name: "wrapped wrong sequence error",
+			err:  sdkerrors.Wrap(sdkerrors.ErrWrongSequence, "account sequence mismatch, expected 2, got 1"),
+			want: true,
+		},
+		{
+			name: "wrong sequence response code",
+			res: cosmosclient.Response{
+				TxResponse: &sdk.TxResponse{
+					Codespace: sdkerrors.RootCodespace,
+					Code:      sdkerrors.ErrWrongSequence.ABCICode(),
+				},
+			},
+			err:  errors.New("SPN error with '32' code"),
+			want: true,
+		},
+		{
+			name: "wrong sequence simulation error",
+			err:  errors.New("rpc error: code = Unknown desc = account sequence mismatch, expected 2, got 1: incorrect account sequence"),
+			want: true,
+		},
+		{
+			name: "other response code",
+			res: cosmosclient.Response{
+				TxResponse: &sdk.TxResponse{
+					Codespace: sdkerrors.RootCodespace,
+					Code:      sdkerrors.ErrInsufficientFunds.ABCICode(),
+				},
+			},
+			err:  errors.New("SPN error with '5' code"),
+			want: false,
+		},
+		{
+			name: "other error",
+			err:  errors.New("connection refused"),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, isWrongSequenceError(tt.res, tt.err))
+		})
+	}
+}
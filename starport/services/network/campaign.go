@@ -0,0 +1,123 @@
+package network
+
+import (
+	"context"
+	"fmt"
+
+	campaigntypes "github.com/tendermint/spn/x/campaign/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+	sperrors "github.com/tendermint/starport/starport/errors"
+	"github.com/tendermint/starport/starport/pkg/cosmoserror"
+	"github.com/tendermint/starport/starport/pkg/events"
+	"github.com/tendermint/starport/starport/services/network/networktypes"
+)
+
+// Campaign fetches the campaign from Starport Network by campaign id
+func (n Network) Campaign(ctx context.Context, campaignID uint64) (networktypes.Campaign, error) {
+	n.ev.Send(events.New(events.StatusOngoing, "Fetching campaign information"))
+	res, err := campaigntypes.NewQueryClient(n.cosmos.Context).Campaign(ctx, &campaigntypes.QueryGetCampaignRequest{
+		CampaignID: campaignID,
+	})
+	if err != nil {
+		return networktypes.Campaign{}, cosmoserror.Unwrap(err)
+	}
+
+	coordRes, err := profiletypes.NewQueryClient(n.cosmos.Context).Coordinator(ctx, &profiletypes.QueryGetCoordinatorRequest{
+		CoordinatorID: res.Campaign.CoordinatorID,
+	})
+	if err != nil {
+		return networktypes.Campaign{}, cosmoserror.Unwrap(err)
+	}
+
+	campaign, err := networktypes.ToCampaign(res.Campaign)
+	if err != nil {
+		return networktypes.Campaign{}, err
+	}
+	campaign.CoordinatorAddress = coordRes.Coordinator.Address
+
+	return campaign, nil
+}
+
+// Campaigns fetches the campaigns from Starport Network
+func (n Network) Campaigns(ctx context.Context) ([]networktypes.Campaign, error) {
+	var campaigns []networktypes.Campaign
+
+	n.ev.Send(events.New(events.StatusOngoing, "Fetching campaigns information"))
+	res, err := campaigntypes.NewQueryClient(n.cosmos.Context).CampaignAll(ctx, &campaigntypes.QueryAllCampaignRequest{})
+	if err != nil {
+		return campaigns, cosmoserror.Unwrap(err)
+	}
+
+	coordRes, err := profiletypes.NewQueryClient(n.cosmos.Context).CoordinatorAll(ctx, &profiletypes.QueryAllCoordinatorRequest{})
+	if err != nil {
+		return campaigns, cosmoserror.Unwrap(err)
+	}
+
+	coordinatorAddresses := make(map[uint64]string)
+	for _, coordinator := range coordRes.Coordinator {
+		coordinatorAddresses[coordinator.CoordinatorID] = coordinator.Address
+	}
+
+	// Parse fetched campaigns, an invalid campaign must not prevent listing the other ones
+	for _, c := range res.Campaign {
+		campaign, err := networktypes.ToCampaign(c)
+		if err != nil {
+			n.ev.Send(events.New(events.StatusDone, fmt.Sprintf("Skipping %s", err)))
+			continue
+		}
+		campaign.CoordinatorAddress = coordinatorAddresses[c.CoordinatorID]
+		campaigns = append(campaigns, campaign)
+	}
+
+	return campaigns, nil
+}
+
+// CampaignShares fetches the shares of the mainnet allocated to the accounts of a campaign
+func (n Network) CampaignShares(ctx context.Context, campaignID uint64) ([]networktypes.CampaignShare, error) {
+	var shares []networktypes.CampaignShare
+
+	n.ev.Send(events.New(events.StatusOngoing, "Fetching campaign shares"))
+	res, err := campaigntypes.NewQueryClient(n.cosmos.Context).MainnetAccountAll(ctx, &campaigntypes.QueryAllMainnetAccountRequest{
+		CampaignID: campaignID,
+	})
+	if err != nil {
+		return shares, cosmoserror.Unwrap(err)
+	}
+
+	for _, acc := range res.MainnetAccount {
+		shares = append(shares, networktypes.ToCampaignShare(acc))
+	}
+
+	return shares, nil
+}
+
+// CampaignChains fetches the launch IDs of the chains associated to a campaign
+func (n Network) CampaignChains(ctx context.Context, campaignID uint64) ([]uint64, error) {
+	n.ev.Send(events.New(events.StatusOngoing, "Fetching campaign chains"))
+	res, err := campaigntypes.NewQueryClient(n.cosmos.Context).CampaignChains(ctx, &campaigntypes.QueryGetCampaignChainsRequest{
+		CampaignID: campaignID,
+	})
+	// a campaign without chains has no campaign chains entry
+	err = cosmoserror.Unwrap(err)
+	if err == cosmoserror.ErrInvalidRequest {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return res.CampaignChains.Chains, nil
+}
+
+// ChainLaunchCampaign fetches the campaign linked to the chain launch from Starport Network,
+// ErrNoCampaignLinked is returned if the chain launch is not linked to a campaign
+func (n Network) ChainLaunchCampaign(ctx context.Context, launchID uint64) (networktypes.Campaign, error) {
+	chainLaunch, err := n.ChainLaunch(ctx, launchID)
+	if err != nil {
+		return networktypes.Campaign{}, err
+	}
+	if chainLaunch.CampaignID == 0 {
+		return networktypes.Campaign{}, sperrors.ErrNoCampaignLinked{LaunchID: launchID}
+	}
+	return n.Campaign(ctx, chainLaunch.CampaignID)
+}
@@ -0,0 +1,112 @@
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	campaigntypes "github.com/tendermint/spn/x/campaign/types"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+	sperrors "github.com/tendermint/starport/starport/errors"
+	"github.com/tendermint/starport/starport/pkg/events"
+)
+
+const (
+	queryCampaignAll    = "/tendermint.spn.campaign.Query/CampaignAll"
+	queryCampaignChains = "/tendermint.spn.campaign.Query/CampaignChains"
+	queryChain          = "/tendermint.spn.launch.Query/Chain"
+	queryCoordinatorAll = "/tendermint.spn.profile.Query/CoordinatorAll"
+)
+
+func TestCampaignsSkipInvalid(t *testing.T) {
+	bus := events.NewBus()
+	defer bus.Shutdown()
+	go func() {
+		for range bus.Events() {
+		}
+	}()
+
+	n := newTestNetwork(t, testNode{
+		responses: map[string]codec.ProtoMarshaler{
+			queryCampaignAll: &campaigntypes.QueryAllCampaignResponse{
+				Campaign: []campaigntypes.Campaign{
+					campaigntypes.NewCampaign(1, "foo", 1, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)), true),
+					campaigntypes.NewCampaign(2, "", 1, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)), true),
+					campaigntypes.NewCampaign(3, "bar", 2, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)), true),
+				},
+			},
+			queryCoordinatorAll: &profiletypes.QueryAllCoordinatorResponse{
+				Coordinator: []profiletypes.Coordinator{
+					{CoordinatorID: 1, Address: testAddress("alice")},
+					{CoordinatorID: 2, Address: testAddress("bob")},
+				},
+			},
+		},
+	}, CollectEvents(bus))
+
+	campaigns, err := n.Campaigns(context.Background())
+	require.NoError(t, err)
+	require.Len(t, campaigns, 2)
+	require.Equal(t, uint64(1), campaigns[0].ID)
+	require.Equal(t, testAddress("alice"), campaigns[0].CoordinatorAddress)
+	require.Equal(t, uint64(3), campaigns[1].ID)
+	require.Equal(t, testAddress("bob"), campaigns[1].CoordinatorAddress)
+
+	var skipped []string
+	for _, e := range bus.History(100) {
+		if events.FilterByMessage("Skipping")(e) {
+			skipped = append(skipped, e.Text())
+		}
+	}
+	require.Equal(t, []string{"Skipping invalid campaign 2: campaign name can't be empty"}, skipped)
+}
+
+func TestCampaignChains(t *testing.T) {
+	t.Run("campaign with chains", func(t *testing.T) {
+		n := newTestNetwork(t, testNode{
+			responses: map[string]codec.ProtoMarshaler{
+				queryCampaignChains: &campaigntypes.QueryGetCampaignChainsResponse{
+					CampaignChains: campaigntypes.CampaignChains{CampaignID: 1, Chains: []uint64{1, 2}},
+				},
+			},
+		})
+
+		chains, err := n.CampaignChains(context.Background(), 1)
+		require.NoError(t, err)
+		require.Equal(t, []uint64{1, 2}, chains)
+	})
+
+	t.Run("campaign without chains", func(t *testing.T) {
+		n := newTestNetwork(t, testNode{
+			errors: map[string]*sdkerrors.Error{queryCampaignChains: sdkerrors.ErrInvalidRequest},
+		})
+
+		chains, err := n.CampaignChains(context.Background(), 1)
+		require.NoError(t, err)
+		require.Empty(t, chains)
+	})
+
+	t.Run("query error", func(t *testing.T) {
+		n := newTestNetwork(t, testNode{
+			errors: map[string]*sdkerrors.Error{queryCampaignChains: sdkerrors.ErrUnauthorized},
+		})
+
+		_, err := n.CampaignChains(context.Background(), 1)
+		require.Error(t, err)
+	})
+}
+
+func TestChainLaunchCampaignNotLinked(t *testing.T) {
+	n := newTestNetwork(t, testNode{
+		responses: map[string]codec.ProtoMarshaler{
+			queryChain: &launchtypes.QueryGetChainResponse{Chain: launchtypes.Chain{LaunchID: 1}},
+		},
+	})
+
+	_, err := n.ChainLaunchCampaign(context.Background(), 1)
+	require.Equal(t, sperrors.ErrNoCampaignLinked{LaunchID: 1}, err)
+}
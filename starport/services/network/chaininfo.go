@@ -0,0 +1,115 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/starport/starport/pkg/events"
+	"github.com/tendermint/starport/starport/pkg/httpstatuschecker"
+	"github.com/tendermint/starport/starport/pkg/xstrings"
+	"github.com/tendermint/starport/starport/services/network/networktypes"
+)
+
+// faultTolerantValidatorCount is the minimum number of validators for a chain to tolerate a faulty validator
+const faultTolerantValidatorCount = 4
+
+// ValidateChainInfo checks the launch information of a chain is coherent before triggering its launch,
+// it returns the non-fatal issues as warnings and an error if a requirement to launch the chain is not met
+func (n Network) ValidateChainInfo(ctx context.Context, launchID uint64) (warnings []string, err error) {
+	chainLaunch, err := n.ChainLaunch(ctx, launchID)
+	if err != nil {
+		return nil, err
+	}
+	gi, err := n.GenesisInformation(ctx, launchID)
+	if err != nil {
+		return nil, err
+	}
+
+	n.ev.Send(events.New(events.StatusOngoing, "Validating the chain information"))
+
+	var errs networktypes.ValidationErrors
+
+	// check the validator set
+	switch validatorCount := len(gi.GenesisValidators); {
+	case validatorCount < n.minValidators:
+		errs = append(errs, fmt.Errorf("the chain has %d genesis validators, at least %d required", validatorCount, n.minValidators))
+	case validatorCount < faultTolerantValidatorCount:
+		warnings = append(warnings, fmt.Sprintf(
+			"the chain has %d genesis validators, at least %d are needed to tolerate a faulty validator",
+			validatorCount,
+			faultTolerantValidatorCount,
+		))
+	}
+
+	stakingTokens := sdk.NewCoins()
+	nodeIDs := make(map[string]string)
+	for _, val := range gi.GenesisValidators {
+		switch err := val.SelfDelegation.Validate(); {
+		case err != nil:
+			errs = append(errs, fmt.Errorf("genesis validator %s has an invalid self-delegation: %w", val.Address, err))
+		case val.SelfDelegation.Amount.LT(n.minSelfDelegation):
+			errs = append(errs, fmt.Errorf(
+				"genesis validator %s self-delegates %s, at least %s required",
+				val.Address,
+				val.SelfDelegation,
+				n.minSelfDelegation,
+			))
+		default:
+			stakingTokens = stakingTokens.Add(val.SelfDelegation)
+		}
+
+		nodeID := strings.Split(val.Peer, "@")[0]
+		if other, ok := nodeIDs[nodeID]; ok {
+			errs = append(errs, fmt.Errorf("genesis validators %s and %s use the same node ID %s", other, val.Address, nodeID))
+			continue
+		}
+		nodeIDs[nodeID] = val.Address
+	}
+	if len(gi.GenesisValidators) > 0 && !stakingTokens.IsAllPositive() {
+		errs = append(errs, errors.New("the genesis validators have no staking tokens"))
+	}
+	if len(stakingTokens) > 1 {
+		warnings = append(warnings, fmt.Sprintf("the genesis validators self-delegate different denoms: %s", stakingTokens))
+	}
+
+	// check the accounts
+	for _, acc := range gi.GenesisAccounts {
+		if err := xstrings.ValidateBech32(acc.Address); err != nil {
+			errs = append(errs, fmt.Errorf("genesis account %s has an invalid address: %w", acc.Address, err))
+		}
+		if _, err := sdk.ParseCoinsNormalized(acc.Coins); err != nil {
+			errs = append(errs, fmt.Errorf("genesis account %s has invalid coins: %w", acc.Address, err))
+		}
+	}
+	for _, acc := range gi.VestingAccounts {
+		if err := xstrings.ValidateBech32(acc.Address); err != nil {
+			errs = append(errs, fmt.Errorf("vesting account %s has an invalid address: %w", acc.Address, err))
+		}
+		if _, err := sdk.ParseCoinsNormalized(acc.TotalBalance); err != nil {
+			errs = append(errs, fmt.Errorf("vesting account %s has an invalid total balance: %w", acc.Address, err))
+		}
+		if _, err := sdk.ParseCoinsNormalized(acc.Vesting); err != nil {
+			errs = append(errs, fmt.Errorf("vesting account %s has invalid vesting coins: %w", acc.Address, err))
+		}
+	}
+
+	// check the custom genesis can be fetched
+	if chainLaunch.GenesisURL != "" {
+		reachable, err := httpstatuschecker.Check(ctx, chainLaunch.GenesisURL, httpstatuschecker.Method(http.MethodHead))
+		if err != nil || !reachable {
+			errs = append(errs, fmt.Errorf("the genesis URL %s is not reachable", chainLaunch.GenesisURL))
+		}
+	}
+
+	if len(errs) > 0 {
+		return warnings, errs
+	}
+
+	n.ev.Send(events.New(events.StatusDone, "Chain information validated"))
+
+	return warnings, nil
+}
@@ -0,0 +1,193 @@
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+	"github.com/tendermint/starport/starport/services/network/networktypes"
+)
+
+const (
+	queryGenesisAccountAll   = "/tendermint.spn.launch.Query/GenesisAccountAll"
+	queryVestingAccountAll   = "/tendermint.spn.launch.Query/VestingAccountAll"
+	queryGenesisValidatorAll = "/tendermint.spn.launch.Query/GenesisValidatorAll"
+)
+
+// testAddress returns a valid bech32 account address derived from name.
+func testAddress(name string) string {
+	addr := make([]byte, 20)
+	copy(addr, name)
+	return sdk.AccAddress(addr).String()
+}
+
+// testGenesisValidator returns a genesis validator of the launch self-delegating amount of stake.
+func testGenesisValidator(name string, amount int64) launchtypes.GenesisValidator {
+	return launchtypes.GenesisValidator{
+		LaunchID:       1,
+		Address:        testAddress(name),
+		Peer:           name + "@" + name + ".com:26656",
+		SelfDelegation: sdk.NewInt64Coin("stake", amount),
+	}
+}
+
+func TestValidateChainInfo(t *testing.T) {
+	var (
+		validators = []launchtypes.GenesisValidator{
+			testGenesisValidator("alice", 100),
+			testGenesisValidator("bob", 100),
+			testGenesisValidator("carol", 100),
+			testGenesisValidator("dave", 100),
+		}
+		accounts = []launchtypes.GenesisAccount{
+			{LaunchID: 1, Address: testAddress("alice"), Coins: sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))},
+		}
+		vestingAccounts = []launchtypes.VestingAccount{
+			{
+				LaunchID: 1,
+				Address:  testAddress("bob"),
+				VestingOptions: *launchtypes.NewDelayedVesting(
+					sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)),
+					sdk.NewCoins(sdk.NewInt64Coin("stake", 500)),
+					1000,
+				),
+			},
+		}
+	)
+
+	tests := []struct {
+		name            string
+		validators      []launchtypes.GenesisValidator
+		accounts        []launchtypes.GenesisAccount
+		vestingAccounts []launchtypes.VestingAccount
+		options         []Option
+		warnings        []string
+		errs            []string
+	}{
+		{
+			name:            "valid chain",
+			validators:      validators,
+			accounts:        accounts,
+			vestingAccounts: vestingAccounts,
+		},
+		{
+			name:       "not fault tolerant",
+			validators: validators[:1],
+			warnings: []string{
+				"the chain has 1 genesis validators, at least 4 are needed to tolerate a faulty validator",
+			},
+		},
+		{
+			name: "no validators",
+			errs: []string{"the chain has 0 genesis validators, at least 1 required"},
+		},
+		{
+			name:       "duplicated node ID",
+			validators: append(validators, testGenesisValidator("alice", 100)),
+			errs: []string{
+				"genesis validators " + testAddress("alice") + " and " + testAddress("alice") + " use the same node ID alice",
+			},
+		},
+		{
+			name:       "invalid account address",
+			validators: validators,
+			accounts: []launchtypes.GenesisAccount{
+				{LaunchID: 1, Address: "cosmos1foo", Coins: accounts[0].Coins},
+			},
+			vestingAccounts: []launchtypes.VestingAccount{
+				{LaunchID: 1, Address: "foo", VestingOptions: vestingAccounts[0].VestingOptions},
+			},
+			errs: []string{
+				"genesis account cosmos1foo has an invalid address: decoding bech32 failed: invalid separator index 6",
+				"vesting account foo has an invalid address: decoding bech32 failed: invalid bech32 string length 3",
+			},
+		},
+		{
+			name:       "invalid account coins",
+			validators: validators,
+			accounts: []launchtypes.GenesisAccount{
+				{LaunchID: 1, Address: testAddress("alice"), Coins: sdk.Coins{{Denom: "s", Amount: sdk.NewInt(1)}}},
+			},
+			errs: []string{
+				"genesis account " + testAddress("alice") + " has invalid coins: invalid decimal coin expression: 1s",
+			},
+		},
+		{
+			name: "invalid self-delegation",
+			validators: []launchtypes.GenesisValidator{
+				validators[0],
+				{
+					LaunchID:       1,
+					Address:        testAddress("bob"),
+					Peer:           "bob@bob.com:26656",
+					SelfDelegation: sdk.Coin{Denom: "s", Amount: sdk.NewInt(100)},
+				},
+			},
+			warnings: []string{
+				"the chain has 2 genesis validators, at least 4 are needed to tolerate a faulty validator",
+			},
+			errs: []string{
+				"genesis validator " + testAddress("bob") + " has an invalid self-delegation: invalid denom: s",
+			},
+		},
+		{
+			name:       "low self-delegation",
+			validators: append(validators[1:], testGenesisValidator("eve", 10)),
+			options:    []Option{WithMinSelfDelegation(sdk.NewInt(50))},
+			errs: []string{
+				"genesis validator " + testAddress("eve") + " self-delegates 10stake, at least 50 required",
+			},
+		},
+		{
+			name:       "no staking tokens",
+			validators: []launchtypes.GenesisValidator{testGenesisValidator("alice", 0)},
+			options:    []Option{WithMinSelfDelegation(sdk.ZeroInt())},
+			warnings: []string{
+				"the chain has 1 genesis validators, at least 4 are needed to tolerate a faulty validator",
+			},
+			errs: []string{"the genesis validators have no staking tokens"},
+		},
+		{
+			name:       "min validators",
+			validators: validators,
+			options:    []Option{WithMinValidators(5)},
+			errs:       []string{"the chain has 4 genesis validators, at least 5 required"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := newTestNetwork(t, testNode{
+				responses: map[string]codec.ProtoMarshaler{
+					queryChain: &launchtypes.QueryGetChainResponse{Chain: launchtypes.Chain{LaunchID: 1}},
+					queryGenesisAccountAll: &launchtypes.QueryAllGenesisAccountResponse{
+						GenesisAccount: tt.accounts,
+					},
+					queryVestingAccountAll: &launchtypes.QueryAllVestingAccountResponse{
+						VestingAccount: tt.vestingAccounts,
+					},
+					queryGenesisValidatorAll: &launchtypes.QueryAllGenesisValidatorResponse{
+						GenesisValidator: tt.validators,
+					},
+				},
+			}, tt.options...)
+
+			warnings, err := n.ValidateChainInfo(context.Background(), 1)
+			require.Equal(t, tt.warnings, warnings)
+			if len(tt.errs) == 0 {
+				require.NoError(t, err)
+				return
+			}
+
+			var errs networktypes.ValidationErrors
+			require.ErrorAs(t, err, &errs)
+			messages := make([]string, len(errs))
+			for i, err := range errs {
+				messages[i] = err.Error()
+			}
+			require.Equal(t, tt.errs, messages)
+		})
+	}
+}
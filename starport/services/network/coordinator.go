@@ -0,0 +1,129 @@
+package network
+
+import (
+	"context"
+	"fmt"
+
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+	sperrors "github.com/tendermint/starport/starport/errors"
+	"github.com/tendermint/starport/starport/pkg/cosmoserror"
+	"github.com/tendermint/starport/starport/pkg/events"
+	"github.com/tendermint/starport/starport/services/network/networkchain"
+	"github.com/tendermint/starport/starport/services/network/networktypes"
+)
+
+// RegisterCoordinator registers the account of the network as a coordinator on Starport Network
+// with the provided description, ErrAlreadyCoordinator is returned if the account is already a coordinator
+func (n Network) RegisterCoordinator(ctx context.Context, identity, website, details string) error {
+	address := n.account.Address(networkchain.SPN)
+
+	n.ev.Send(events.New(events.StatusOngoing, "Registering the coordinator"))
+
+	_, err := profiletypes.
+		NewQueryClient(n.cosmos.Context).
+		CoordinatorByAddress(ctx, &profiletypes.QueryGetCoordinatorByAddressRequest{
+			Address: address,
+		})
+	err = cosmoserror.Unwrap(err)
+	if err == nil {
+		return sperrors.ErrAlreadyCoordinator{Address: address}
+	}
+	if err != cosmoserror.ErrInvalidRequest {
+		return err
+	}
+
+	msg := profiletypes.NewMsgCreateCoordinator(address, identity, website, details)
+	res, err := n.cosmos.BroadcastTx(n.account.Name, msg)
+	if err != nil {
+		return cosmoserror.Unwrap(err)
+	}
+
+	var createCoordinatorRes profiletypes.MsgCreateCoordinatorResponse
+	if err := res.Decode(&createCoordinatorRes); err != nil {
+		return cosmoserror.Unwrap(err)
+	}
+
+	n.ev.Send(events.New(events.StatusDone, fmt.Sprintf("Coordinator %d registered", createCoordinatorRes.CoordinatorID)))
+
+	return nil
+}
+
+// UpdateCoordinatorInfo updates the description of the coordinator of the account of the network on Starport Network,
+// the empty fields keep their current value. ErrCoordinatorNotFound is returned if the account is not a coordinator
+func (n Network) UpdateCoordinatorInfo(ctx context.Context, identity, website, details string) error {
+	address := n.account.Address(networkchain.SPN)
+
+	n.ev.Send(events.New(events.StatusOngoing, "Fetching the coordinator profile"))
+
+	queryClient := profiletypes.NewQueryClient(n.cosmos.Context)
+	addrRes, err := queryClient.CoordinatorByAddress(ctx, &profiletypes.QueryGetCoordinatorByAddressRequest{
+		Address: address,
+	})
+	err = cosmoserror.Unwrap(err)
+	if err == cosmoserror.ErrInvalidRequest {
+		return sperrors.ErrCoordinatorNotFound{Address: address}
+	}
+	if err != nil {
+		return err
+	}
+
+	coordRes, err := queryClient.Coordinator(ctx, &profiletypes.QueryGetCoordinatorRequest{
+		CoordinatorID: addrRes.CoordinatorByAddress.CoordinatorID,
+	})
+	if err != nil {
+		return cosmoserror.Unwrap(err)
+	}
+
+	// keep the current values of the fields that are not updated
+	description := coordRes.Coordinator.Description
+	if identity != "" {
+		description.Identity = identity
+	}
+	if website != "" {
+		description.Website = website
+	}
+	if details != "" {
+		description.Details = details
+	}
+
+	n.ev.Send(events.New(events.StatusOngoing, "Updating the coordinator profile"))
+
+	msg := profiletypes.NewMsgUpdateCoordinatorDescription(
+		address,
+		description.Identity,
+		description.Website,
+		description.Details,
+	)
+	if _, err := n.cosmos.BroadcastTx(n.account.Name, msg); err != nil {
+		return cosmoserror.Unwrap(err)
+	}
+
+	n.ev.Send(events.New(events.StatusDone, "Coordinator profile updated"))
+
+	return nil
+}
+
+// checkCoordinator checks the account of the network is the coordinator of the chain launch,
+// ErrNotCoordinator is returned otherwise
+func (n Network) checkCoordinator(ctx context.Context, chainLaunch networktypes.ChainLaunch) error {
+	address := n.account.Address(networkchain.SPN)
+	notCoordinator := sperrors.ErrNotCoordinator{Address: address, LaunchID: chainLaunch.ID}
+
+	res, err := profiletypes.
+		NewQueryClient(n.cosmos.Context).
+		CoordinatorByAddress(ctx, &profiletypes.QueryGetCoordinatorByAddressRequest{
+			Address: address,
+		})
+	err = cosmoserror.Unwrap(err)
+	if err == cosmoserror.ErrInvalidRequest {
+		return notCoordinator
+	}
+	if err != nil {
+		return err
+	}
+
+	if res.CoordinatorByAddress.CoordinatorID != chainLaunch.CoordinatorID {
+		return notCoordinator
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	launchtypes "github.com/tendermint/spn/x/launch/types"
+	sperrors "github.com/tendermint/starport/starport/errors"
 	"github.com/tendermint/starport/starport/pkg/cosmoserror"
 	"github.com/tendermint/starport/starport/pkg/cosmosutil"
 	"github.com/tendermint/starport/starport/pkg/events"
@@ -180,6 +181,61 @@ func (n Network) sendValidatorRequest(
 	return nil
 }
 
+// SubmitGentx sends a request to add the validator of the gentx to the launch and returns the request ID,
+// the peer of the validator is the one defined in the gentx memo
+func (n Network) SubmitGentx(ctx context.Context, launchID uint64, gentx []byte) (uint64, error) {
+	gentxInfo, gentx, err := cosmosutil.ParseGentx(gentx)
+	if err != nil {
+		return 0, sperrors.ErrInvalidGentx{Reason: err.Error()}
+	}
+	switch {
+	case gentxInfo.DelegatorAddress == "":
+		return 0, sperrors.ErrInvalidGentx{Reason: "missing delegator address"}
+	case len(gentxInfo.PubKey) == 0:
+		return 0, sperrors.ErrInvalidGentx{Reason: "missing validator public key"}
+	case gentxInfo.SelfDelegation.Denom == "":
+		return 0, sperrors.ErrInvalidGentx{Reason: "missing self delegation denom"}
+	case !cosmosutil.VerifyPeerFormat(gentxInfo.Memo):
+		return 0, sperrors.ErrInvalidGentx{
+			Reason: fmt.Sprintf("the memo %q is not a peer address", gentxInfo.Memo),
+		}
+	}
+
+	// change the chain address prefix to spn
+	valAddress, err := cosmosutil.ChangeAddressPrefix(gentxInfo.DelegatorAddress, networkchain.SPN)
+	if err != nil {
+		return 0, sperrors.ErrInvalidGentx{Reason: err.Error()}
+	}
+
+	msg := launchtypes.NewMsgRequestAddValidator(
+		n.account.Address(networkchain.SPN),
+		launchID,
+		valAddress,
+		gentx,
+		gentxInfo.PubKey,
+		gentxInfo.SelfDelegation,
+		gentxInfo.Memo,
+	)
+
+	n.ev.Send(events.New(events.StatusOngoing, "Broadcasting validator transaction"))
+
+	res, err := n.cosmos.BroadcastTx(n.account.Name, msg)
+	if err != nil {
+		return 0, cosmoserror.Unwrap(err)
+	}
+
+	var requestRes launchtypes.MsgRequestAddValidatorResponse
+	if err := res.Decode(&requestRes); err != nil {
+		return 0, cosmoserror.Unwrap(err)
+	}
+
+	n.ev.Send(events.New(events.StatusDone,
+		fmt.Sprintf("Request %d to add validator %s has been submitted!", requestRes.RequestID, valAddress),
+	))
+
+	return requestRes.RequestID, nil
+}
+
 // hasValidator verify if the validator already exist into the SPN store
 func (n Network) hasValidator(ctx context.Context, launchID uint64, address string) (bool, error) {
 	_, err := launchtypes.NewQueryClient(n.cosmos.Context).GenesisValidator(ctx, &launchtypes.QueryGetGenesisValidatorRequest{
@@ -3,9 +3,11 @@ package network
 import (
 	"context"
 	"fmt"
+	"os"
 	"time"
 
 	launchtypes "github.com/tendermint/spn/x/launch/types"
+	sperrors "github.com/tendermint/starport/starport/errors"
 	"github.com/tendermint/starport/starport/pkg/cosmoserror"
 	"github.com/tendermint/starport/starport/pkg/events"
 	"github.com/tendermint/starport/starport/pkg/xtime"
@@ -21,12 +23,44 @@ func (n Network) LaunchParams(ctx context.Context) (launchtypes.Params, error) {
 	return res.GetParams(), nil
 }
 
-// TriggerLaunch launches a chain as a coordinator
-func (n Network) TriggerLaunch(ctx context.Context, launchID uint64, remainingTime time.Duration) error {
+// TriggerLaunch launches a chain as a coordinator at launchTime, the minimum launch time of SPN is used
+// when launchTime is zero. ErrLaunchAlreadyTriggered is returned if the launch is already triggered,
+// ErrNotCoordinator if the account of the network is not the coordinator of the chain and
+// ErrInsufficientValidators if the chain has less genesis validators than the minimum
+func (n Network) TriggerLaunch(ctx context.Context, launchID uint64, launchTime time.Time) error {
+	_, err := n.TriggerLaunchTx(ctx, launchID, launchTime)
+	return err
+}
+
+// TriggerLaunchTx launches a chain like TriggerLaunch and returns the hash of the launch transaction
+func (n Network) TriggerLaunchTx(ctx context.Context, launchID uint64, launchTime time.Time) (txHash string, err error) {
+	// SPN expects the time remaining before the launch, it is rounded to absorb the delay of the call
+	var remainingTime time.Duration
+	if !launchTime.IsZero() {
+		remainingTime = time.Until(launchTime).Round(time.Second)
+	}
+
 	n.ev.Send(events.New(events.StatusOngoing, fmt.Sprintf("Launching chain %d", launchID)))
+
+	chainLaunch, err := n.ChainLaunch(ctx, launchID)
+	if err != nil {
+		return "", err
+	}
+	if chainLaunch.LaunchTriggered {
+		return "", sperrors.ErrLaunchAlreadyTriggered{LaunchID: launchID}
+	}
+
+	if err := n.checkCoordinator(ctx, chainLaunch); err != nil {
+		return "", err
+	}
+
+	if err := n.checkMinValidators(ctx, launchID); err != nil {
+		return "", err
+	}
+
 	params, err := n.LaunchParams(ctx)
 	if err != nil {
-		return cosmoserror.Unwrap(err)
+		return "", cosmoserror.Unwrap(err)
 	}
 
 	var (
@@ -35,16 +69,16 @@ func (n Network) TriggerLaunch(ctx context.Context, launchID uint64, remainingTi
 		address   = n.account.Address(networkchain.SPN)
 	)
 	switch {
-	case remainingTime == 0:
-		// if the user does not specify the remaining time, use the minimal one
+	case launchTime.IsZero():
+		// if the user does not specify the launch time, use the minimal one
 		remainingTime = minLaunch
 	case remainingTime < minLaunch:
-		return fmt.Errorf("remaining time %s lower than minimum %s",
-			xtime.NowAfter(remainingTime),
+		return "", fmt.Errorf("launch time %s lower than minimum %s",
+			xtime.FormatUnix(launchTime),
 			xtime.NowAfter(minLaunch))
 	case remainingTime > maxLaunch:
-		return fmt.Errorf("remaining time %s greater than maximum %s",
-			xtime.NowAfter(remainingTime),
+		return "", fmt.Errorf("launch time %s greater than maximum %s",
+			xtime.FormatUnix(launchTime),
 			xtime.NowAfter(maxLaunch))
 	}
 
@@ -52,16 +86,100 @@ func (n Network) TriggerLaunch(ctx context.Context, launchID uint64, remainingTi
 	n.ev.Send(events.New(events.StatusOngoing, "Setting launch time"))
 	res, err := n.cosmos.BroadcastTx(n.account.Name, msg)
 	if err != nil {
-		return cosmoserror.Unwrap(err)
+		return "", cosmoserror.Unwrap(err)
 	}
 
 	var launchRes launchtypes.MsgTriggerLaunchResponse
 	if err := res.Decode(&launchRes); err != nil {
-		return cosmoserror.Unwrap(err)
+		return "", cosmoserror.Unwrap(err)
 	}
 
 	n.ev.Send(events.New(events.StatusDone,
 		fmt.Sprintf("Chain %d will be launched on %s", launchID, xtime.NowAfter(remainingTime)),
 	))
+	return res.TxHash, nil
+}
+
+// checkMinValidators checks the chain has at least the minimum number of genesis validators to be launched
+func (n Network) checkMinValidators(ctx context.Context, launchID uint64) error {
+	genVals, err := n.GenesisValidators(ctx, launchID)
+	if err != nil {
+		return err
+	}
+	if len(genVals) < n.minValidators {
+		return sperrors.ErrInsufficientValidators{
+			LaunchID:   launchID,
+			Validators: len(genVals),
+			Required:   n.minValidators,
+		}
+	}
+
+	n.ev.Send(events.New(events.StatusDone, fmt.Sprintf("Chain %d has %d genesis validators", launchID, len(genVals))))
+	return nil
+}
+
+// RevertLaunch reverts a launched chain as a coordinator, resets the genesis time of the chain
+// and returns the hash of the revert transaction
+func (n Network) RevertLaunch(launchID uint64, c *networkchain.Chain) (txHash string, err error) {
+	n.ev.Send(events.New(events.StatusOngoing, fmt.Sprintf("Reverting launched chain %d", launchID)))
+
+	address := n.account.Address(networkchain.SPN)
+	msg := launchtypes.NewMsgRevertLaunch(address, launchID)
+	res, err := n.cosmos.BroadcastTx(n.account.Name, msg)
+	if err != nil {
+		return "", cosmoserror.Unwrap(err)
+	}
+
+	n.ev.Send(events.New(events.StatusDone, fmt.Sprintf("Chain %d launch was reverted", launchID)))
+	n.ev.Send(events.New(events.StatusOngoing, "Resetting the genesis time"))
+
+	if err := c.ResetGenesisTime(); err != nil {
+		return "", err
+	}
+
+	n.ev.Send(events.New(events.StatusDone, "Genesis time was reset"))
+	return res.TxHash, nil
+}
+
+// SimulateRevertLaunch performs the checks of a launch revert and simulates its transaction
+// without broadcasting it, the estimated gas of the transaction is sent as an event
+func (n Network) SimulateRevertLaunch(ctx context.Context, launchID uint64, c *networkchain.Chain) error {
+	n.ev.Send(events.New(events.StatusOngoing, fmt.Sprintf("Simulating the revert of launched chain %d", launchID)))
+
+	res, err := launchtypes.NewQueryClient(n.cosmos.Context).Chain(ctx, &launchtypes.QueryGetChainRequest{
+		LaunchID: launchID,
+	})
+	if err != nil {
+		return cosmoserror.Unwrap(err)
+	}
+
+	// check the launch can be reverted
+	if !res.Chain.LaunchTriggered {
+		return fmt.Errorf("the launch of chain %d is not triggered", launchID)
+	}
+	revertTime := time.Unix(res.Chain.LaunchTimestamp+launchtypes.RevertDelay, 0)
+	if time.Now().Before(revertTime) {
+		return fmt.Errorf("the launch of chain %d can't be reverted before %s", launchID, revertTime.UTC())
+	}
+
+	// check the genesis time of the chain can be reset
+	genesisPath, err := c.GenesisPath()
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(genesisPath); err != nil {
+		return fmt.Errorf("the genesis of chain %d can't be reset: %w", launchID, err)
+	}
+
+	address := n.account.Address(networkchain.SPN)
+	msg := launchtypes.NewMsgRevertLaunch(address, launchID)
+	gas, err := n.cosmos.SimulateTx(n.account.Name, msg)
+	if err != nil {
+		return cosmoserror.Unwrap(err)
+	}
+
+	n.ev.Send(events.New(events.StatusDone,
+		fmt.Sprintf("Chain %d launch can be reverted, estimated gas: %d", launchID, gas),
+	))
 	return nil
 }
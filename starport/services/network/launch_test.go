@@ -0,0 +1,100 @@
+package network
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+	sperrors "github.com/tendermint/starport/starport/errors"
+	"github.com/tendermint/starport/starport/services/network/networkchain"
+)
+
+const queryCoordinatorByAddress = "/tendermint.spn.profile.Query/CoordinatorByAddress"
+
+// coordinatorResponse returns the coordinator response of the account of n for coordinatorID.
+func coordinatorResponse(n Network, coordinatorID uint64) *profiletypes.QueryGetCoordinatorByAddressResponse {
+	return &profiletypes.QueryGetCoordinatorByAddressResponse{
+		CoordinatorByAddress: profiletypes.CoordinatorByAddress{
+			Address:       n.account.Address(networkchain.SPN),
+			CoordinatorID: coordinatorID,
+		},
+	}
+}
+
+func TestTriggerLaunchAlreadyTriggered(t *testing.T) {
+	n := newTestNetwork(t, testNode{
+		responses: map[string]codec.ProtoMarshaler{
+			queryChain: &launchtypes.QueryGetChainResponse{
+				Chain: launchtypes.Chain{LaunchID: 1, LaunchTriggered: true},
+			},
+		},
+	})
+
+	err := n.TriggerLaunch(context.Background(), 1, time.Time{})
+	require.Equal(t, sperrors.ErrLaunchAlreadyTriggered{LaunchID: 1}, err)
+}
+
+func TestTriggerLaunchNotCoordinator(t *testing.T) {
+	tests := []struct {
+		name          string
+		coordinatorID uint64
+	}{
+		{
+			name:          "other coordinator",
+			coordinatorID: 2,
+		},
+		{
+			name: "not a coordinator",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := testNode{
+				responses: map[string]codec.ProtoMarshaler{
+					queryChain: &launchtypes.QueryGetChainResponse{
+						Chain: launchtypes.Chain{LaunchID: 1, CoordinatorID: 1},
+					},
+				},
+				errors: map[string]*sdkerrors.Error{},
+			}
+			n := newTestNetwork(t, node)
+			if tt.coordinatorID == 0 {
+				node.errors[queryCoordinatorByAddress] = sdkerrors.ErrInvalidRequest
+			} else {
+				node.responses[queryCoordinatorByAddress] = coordinatorResponse(n, tt.coordinatorID)
+			}
+
+			err := n.TriggerLaunch(context.Background(), 1, time.Time{})
+			require.Equal(t, sperrors.ErrNotCoordinator{
+				Address:  n.account.Address(networkchain.SPN),
+				LaunchID: 1,
+			}, err)
+		})
+	}
+}
+
+func TestTriggerLaunchInsufficientValidators(t *testing.T) {
+	node := testNode{
+		responses: map[string]codec.ProtoMarshaler{
+			queryChain: &launchtypes.QueryGetChainResponse{
+				Chain: launchtypes.Chain{LaunchID: 1, CoordinatorID: 1},
+			},
+			queryGenesisValidatorAll: &launchtypes.QueryAllGenesisValidatorResponse{
+				GenesisValidator: []launchtypes.GenesisValidator{
+					testGenesisValidator("alice", 100),
+					testGenesisValidator("bob", 100),
+				},
+			},
+		},
+	}
+	n := newTestNetwork(t, node, WithMinValidators(3))
+	node.responses[queryCoordinatorByAddress] = coordinatorResponse(n, 1)
+
+	err := n.TriggerLaunch(context.Background(), 1, time.Now().Add(time.Hour))
+	require.Equal(t, sperrors.ErrInsufficientValidators{LaunchID: 1, Validators: 2, Required: 3}, err)
+}
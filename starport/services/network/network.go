@@ -2,19 +2,40 @@ package network
 
 import (
 	"context"
+	"fmt"
 	"strconv"
+	"strings"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/pkg/errors"
 	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
 	"github.com/tendermint/starport/starport/pkg/cosmosclient"
 	"github.com/tendermint/starport/starport/pkg/events"
+	"github.com/tendermint/starport/starport/pkg/numbers"
+)
+
+const (
+	// defaultRequestBatchSize is the default maximum number of requests settled in a single transaction.
+	defaultRequestBatchSize = 20
+
+	// defaultMinValidators is the default minimum number of genesis validators required to launch a chain.
+	defaultMinValidators = 1
+
+	// defaultMinSelfDelegation is the default minimum amount self-delegated by a genesis validator.
+	defaultMinSelfDelegation = 1
+
+	// maxIDRangeSize is the maximum number of IDs of a range of an ID list.
+	maxIDRangeSize = 1000
 )
 
 // Network is network builder.
 type Network struct {
-	ev      events.Bus
-	cosmos  cosmosclient.Client
-	account cosmosaccount.Account
+	ev                events.Bus
+	cosmos            cosmosclient.Client
+	account           cosmosaccount.Account
+	requestBatchSize  int
+	minValidators     int
+	minSelfDelegation sdk.Int
 }
 
 type Chain interface {
@@ -37,11 +58,35 @@ func CollectEvents(ev events.Bus) Option {
 	}
 }
 
+// WithRequestBatchSize sets the maximum number of requests settled in a single transaction.
+func WithRequestBatchSize(size int) Option {
+	return func(b *Network) {
+		b.requestBatchSize = size
+	}
+}
+
+// WithMinValidators sets the minimum number of genesis validators required to launch a chain.
+func WithMinValidators(n int) Option {
+	return func(b *Network) {
+		b.minValidators = n
+	}
+}
+
+// WithMinSelfDelegation sets the minimum amount self-delegated by each genesis validator to launch a chain.
+func WithMinSelfDelegation(amount sdk.Int) Option {
+	return func(b *Network) {
+		b.minSelfDelegation = amount
+	}
+}
+
 // New creates a Builder.
 func New(cosmos cosmosclient.Client, account cosmosaccount.Account, options ...Option) (Network, error) {
 	n := Network{
-		cosmos:  cosmos,
-		account: account,
+		cosmos:            cosmos,
+		account:           account,
+		requestBatchSize:  defaultRequestBatchSize,
+		minValidators:     defaultMinValidators,
+		minSelfDelegation: sdk.NewInt(defaultMinSelfDelegation),
 	}
 	for _, opt := range options {
 		opt(&n)
@@ -59,3 +104,52 @@ func ParseLaunchID(id string) (uint64, error) {
 	}
 	return launchID, nil
 }
+
+// ParseIDList parses a comma-separated list of IDs where ranges of IDs can be written as start-end,
+// e.g. "1,2,5-8,10", and returns the sorted list of unique IDs
+func ParseIDList(s string) ([]uint64, error) {
+	// the IDs are checked before being expanded by numbers.ParseList which is more permissive
+	for _, token := range strings.Split(s, ",") {
+		token = strings.TrimSpace(token)
+		if token == "" {
+			return nil, fmt.Errorf("empty ID in list %q", s)
+		}
+
+		bounds := strings.Split(token, "-")
+		if len(bounds) > 2 {
+			return nil, fmt.Errorf("invalid ID range %q", token)
+		}
+
+		start, err := parseListID(bounds[0])
+		if err != nil {
+			return nil, err
+		}
+		if len(bounds) == 1 {
+			continue
+		}
+		end, err := parseListID(bounds[1])
+		if err != nil {
+			return nil, err
+		}
+		if start > end {
+			return nil, fmt.Errorf("invalid ID range %q: start is greater than end", token)
+		}
+		if end-start >= maxIDRangeSize {
+			return nil, fmt.Errorf("invalid ID range %q: more than %d IDs", token, maxIDRangeSize)
+		}
+	}
+
+	return numbers.ParseList(s)
+}
+
+// parseListID parses an ID of an ID list, the ID must be a positive integer
+func parseListID(s string) (uint64, error) {
+	id, err := strconv.ParseUint(strings.TrimSpace(s), 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "error parsing ID %q", s)
+	}
+	if id == 0 {
+		return 0, errors.New("ID must be greater than 0")
+	}
+	return id, nil
+}
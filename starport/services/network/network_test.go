@@ -1,10 +1,21 @@
 package network
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
+	"github.com/tendermint/starport/starport/pkg/cosmosclient"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 )
 
 func TestParseLaunchID(t *testing.T) {
@@ -48,3 +59,131 @@ func TestParseLaunchID(t *testing.T) {
 		})
 	}
 }
+
+// idRange returns the IDs from start to end included.
+func idRange(start, end uint64) []uint64 {
+	var ids []uint64
+	for id := start; id <= end; id++ {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func TestParseIDList(t *testing.T) {
+	tests := []struct {
+		name string
+		ids  string
+		want []uint64
+		err  error
+	}{
+		{
+			name: "single id",
+			ids:  "1",
+			want: []uint64{1},
+		},
+		{
+			name: "list with ranges",
+			ids:  "10, 1,2,5-8",
+			want: []uint64{1, 2, 5, 6, 7, 8, 10},
+		},
+		{
+			name: "duplicated ids",
+			ids:  "3,1-4,2",
+			want: []uint64{1, 2, 3, 4},
+		},
+		{
+			name: "invalid id",
+			ids:  "1,foo",
+			err:  errors.New("error parsing ID \"foo\": strconv.ParseUint: parsing \"foo\": invalid syntax"),
+		},
+		{
+			name: "zero id",
+			ids:  "0-2",
+			err:  errors.New("ID must be greater than 0"),
+		},
+		{
+			name: "empty id",
+			ids:  "1,,2",
+			err:  errors.New("empty ID in list \"1,,2\""),
+		},
+		{
+			name: "invalid range",
+			ids:  "1-2-3",
+			err:  errors.New("invalid ID range \"1-2-3\""),
+		},
+		{
+			name: "reversed range",
+			ids:  "8-5",
+			err:  errors.New("invalid ID range \"8-5\": start is greater than end"),
+		},
+		{
+			name: "max range",
+			ids:  "1-1000",
+			want: idRange(1, 1000),
+		},
+		{
+			name: "range too large",
+			ids:  "1-18446744073709551615",
+			err:  errors.New("invalid ID range \"1-18446744073709551615\": more than 1000 IDs"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseIDList(tt.ids)
+			if tt.err != nil {
+				require.Error(t, err)
+				require.Equal(t, tt.err.Error(), err.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// testNode is a Tendermint RPC client answering the ABCI queries sent to SPN by the tests,
+// responses and errors are keyed by the gRPC method of the queries.
+type testNode struct {
+	rpcclient.Client
+
+	responses map[string]codec.ProtoMarshaler
+	errors    map[string]*sdkerrors.Error
+}
+
+// ABCIQueryWithOptions implements rpcclient.ABCIClient.
+func (n testNode) ABCIQueryWithOptions(
+	_ context.Context,
+	path string,
+	_ tmbytes.HexBytes,
+	_ rpcclient.ABCIQueryOptions,
+) (*ctypes.ResultABCIQuery, error) {
+	if err, ok := n.errors[path]; ok {
+		return &ctypes.ResultABCIQuery{Response: abci.ResponseQuery{
+			Code:      err.ABCICode(),
+			Codespace: err.Codespace(),
+			Log:       err.Error(),
+		}}, nil
+	}
+
+	res, ok := n.responses[path]
+	if !ok {
+		return nil, fmt.Errorf("unexpected query %s", path)
+	}
+	value, err := res.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultABCIQuery{Response: abci.ResponseQuery{Value: value}}, nil
+}
+
+// newTestNetwork creates a network querying SPN through node with an in-memory account.
+func newTestNetwork(t *testing.T, node testNode, options ...Option) Network {
+	registry, err := cosmosaccount.New(cosmosaccount.WithKeyringBackend(cosmosaccount.KeyringMemory))
+	require.NoError(t, err)
+	account, _, err := registry.Create("test")
+	require.NoError(t, err)
+
+	n, err := New(cosmosclient.Client{Context: client.Context{}.WithClient(node)}, account, options...)
+	require.NoError(t, err)
+	return n
+}
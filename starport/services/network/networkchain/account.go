@@ -3,18 +3,23 @@ package networkchain
 import (
 	"context"
 	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
+	"sync"
 
+	"github.com/cosmos/go-bip39"
 	chaincmdrunner "github.com/tendermint/starport/starport/pkg/chaincmd/runner"
+	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
 	"github.com/tendermint/starport/starport/pkg/cosmosutil"
 	"github.com/tendermint/starport/starport/pkg/randstr"
 	"github.com/tendermint/starport/starport/services/chain"
 )
 
 const (
-	passphraseLength = 32
-	sampleAccount    = "alice"
+	passphraseLength    = 32
+	mnemonicEntropySize = 256
+	sampleAccount       = "alice"
 )
 
 // InitAccount initializes an account for the blockchain and issue a gentx in config/gentx/gentx.json
@@ -51,6 +56,48 @@ func (c Chain) InitAccount(ctx context.Context, v chain.Validator, accountName s
 	return gentxPath, os.Rename(issuedGentxPath, gentxPath)
 }
 
+// ShowAccountAddress returns the address of the account from the keyring of the chain,
+// the addresses are cached to not query the keyring again for the same account
+func (c *Chain) ShowAccountAddress(ctx context.Context, accountName string) (string, error) {
+	addresses := c.accountAddressCache()
+	if address, ok := addresses.Load(accountName); ok {
+		return address.(string), nil
+	}
+
+	chainCmd, err := c.chain.Commands(ctx)
+	if err != nil {
+		return "", err
+	}
+	account, err := chainCmd.ShowAccount(ctx, accountName)
+	if err != nil {
+		return "", err
+	}
+
+	addresses.Store(accountName, account.Address)
+	return account.Address, nil
+}
+
+// accountAddressCache returns the cached addresses of the accounts, the cache is created on first use
+// for the chains not created with New
+func (c *Chain) accountAddressCache() *sync.Map {
+	if c.accountAddresses == nil {
+		c.accountAddresses = &sync.Map{}
+	}
+	return c.accountAddresses
+}
+
+// clearAccountAddresses clears the cached addresses of the accounts, it must be called each time the
+// keyring of the chain changes. the cache is cleared in place since it is shared by the copies of the chain
+func (c Chain) clearAccountAddresses() {
+	if c.accountAddresses == nil {
+		return
+	}
+	c.accountAddresses.Range(func(name, _ interface{}) bool {
+		c.accountAddresses.Delete(name)
+		return true
+	})
+}
+
 // ImportAccount imports an account from Starport into the chain.
 // we first export the account into a temporary key file and import it with the chain CLI.
 func (c *Chain) ImportAccount(ctx context.Context, name string) (string, error) {
@@ -81,6 +128,7 @@ func (c *Chain) ImportAccount(ctx context.Context, name string) (string, error)
 	}
 
 	acc, err := chainCmd.ImportAccount(ctx, name, keyFile.Name(), passphrase)
+	c.clearAccountAddresses()
 	return acc.Address, err
 }
 
@@ -97,6 +145,7 @@ func (c Chain) detectPrefix(ctx context.Context) (string, error) {
 	if errors.Is(err, chaincmdrunner.ErrAccountDoesNotExist) {
 		// the sample account doesn't exist, we create it
 		acc, err = chainCmd.AddAccount(ctx, sampleAccount, "", "")
+		c.clearAccountAddresses()
 	}
 	if err != nil {
 		return "", err
@@ -104,3 +153,52 @@ func (c Chain) detectPrefix(ctx context.Context) (string, error) {
 
 	return cosmosutil.GetAddressPrefix(acc.Address)
 }
+
+// AddAccount adds an account into the chain keyring from a mnemonic,
+// a new mnemonic is generated when mnemonic is empty
+func (c *Chain) AddAccount(ctx context.Context, name, mnemonic string) (cosmosaccount.Account, error) {
+	if mnemonic == "" {
+		entropySeed, err := bip39.NewEntropy(mnemonicEntropySize)
+		if err != nil {
+			return cosmosaccount.Account{}, err
+		}
+		mnemonic, err = bip39.NewMnemonic(entropySeed)
+		if err != nil {
+			return cosmosaccount.Account{}, err
+		}
+	}
+
+	// the chain commands use the keyring backend of the chain.
+	chainCmd, err := c.chain.Commands(ctx)
+	if err != nil {
+		return cosmosaccount.Account{}, err
+	}
+
+	acc, err := chainCmd.AddAccount(ctx, name, mnemonic, "")
+	c.clearAccountAddresses()
+	if err != nil {
+		return cosmosaccount.Account{}, err
+	}
+
+	// derive the account info from the mnemonic to return a populated account.
+	registry, err := cosmosaccount.New(cosmosaccount.WithKeyringBackend(cosmosaccount.KeyringMemory))
+	if err != nil {
+		return cosmosaccount.Account{}, err
+	}
+	account, err := registry.Import(name, mnemonic, "")
+	if err != nil {
+		return cosmosaccount.Account{}, err
+	}
+	account.Mnemonic = mnemonic
+
+	// ensure the chain keyring and the derived account match.
+	prefix, err := cosmosutil.GetAddressPrefix(acc.Address)
+	if err != nil {
+		return cosmosaccount.Account{}, err
+	}
+	if address := account.Address(prefix); address != acc.Address {
+		return cosmosaccount.Account{}, fmt.Errorf("account address mismatch: chain keyring has %s, expected %s", acc.Address, address)
+	}
+
+	return account, nil
+}
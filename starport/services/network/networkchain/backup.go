@@ -0,0 +1,124 @@
+package networkchain
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/tendermint/starport/starport/pkg/events"
+)
+
+// backupExcludedPaths are the paths of the chain home, relative to the home, excluded from backups
+var backupExcludedPaths = []string{
+	filepath.Join("wasm", "cache"),
+	filepath.Join("data", "cs.wal"),
+}
+
+// BackupHome creates a timestamped tar.gz archive of the chain home inside destDir and returns the archive path
+func (c *Chain) BackupHome(ctx context.Context, destDir string) (string, error) {
+	chainID, err := c.ID()
+	if err != nil {
+		return "", err
+	}
+	chainHome, err := c.chain.Home()
+	if err != nil {
+		return "", err
+	}
+
+	c.ev.Send(events.New(events.StatusOngoing, "Backing up the chain home"))
+
+	if err := os.MkdirAll(destDir, 0755); err != nil {
+		return "", err
+	}
+	archivePath := filepath.Join(destDir, fmt.Sprintf("%s-%s.tar.gz", chainID, time.Now().UTC().Format("20060102150405")))
+
+	if err := archiveDir(ctx, chainHome, archivePath, backupExcludedPaths); err != nil {
+		os.Remove(archivePath)
+		return "", err
+	}
+
+	info, err := os.Stat(archivePath)
+	if err != nil {
+		return "", err
+	}
+
+	c.ev.Send(events.New(events.StatusDone, fmt.Sprintf("Chain home backed up in %s (%d bytes)", archivePath, info.Size())))
+
+	return archivePath, nil
+}
+
+// archiveDir writes the content of dir, except the excluded paths relative to dir, into a tar.gz archive at archivePath
+func archiveDir(ctx context.Context, dir, archivePath string, excludedPaths []string) error {
+	archive, err := os.Create(archivePath)
+	if err != nil {
+		return err
+	}
+	defer archive.Close()
+
+	gzw := gzip.NewWriter(archive)
+	tw := tar.NewWriter(gzw)
+
+	err = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		relPath, err := filepath.Rel(dir, path)
+		if err != nil || relPath == "." {
+			return err
+		}
+		for _, excluded := range excludedPaths {
+			if relPath == excluded {
+				if info.IsDir() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+		}
+
+		// only directories and regular files are archived
+		if !info.IsDir() && !info.Mode().IsRegular() {
+			return nil
+		}
+
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(relPath)
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		_, err = io.Copy(tw, file)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	if err := gzw.Close(); err != nil {
+		return err
+	}
+	return archive.Close()
+}
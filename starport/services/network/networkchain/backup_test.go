@@ -0,0 +1,57 @@
+package networkchain
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchiveDir(t *testing.T) {
+	dir := t.TempDir()
+	for _, file := range []string{
+		"config/node_key.json",
+		"data/cs.wal/wal",
+		"data/blockstore.db",
+		"wasm/cache/module",
+	} {
+		path := filepath.Join(dir, file)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(file), 0644))
+	}
+
+	archivePath := filepath.Join(t.TempDir(), "backup.tar.gz")
+	require.NoError(t, archiveDir(context.Background(), dir, archivePath, backupExcludedPaths))
+
+	archive, err := os.Open(archivePath)
+	require.NoError(t, err)
+	defer archive.Close()
+	gzr, err := gzip.NewReader(archive)
+	require.NoError(t, err)
+	tr := tar.NewReader(gzr)
+
+	files := make(map[string]string)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		content, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		files[header.Name] = string(content)
+	}
+
+	require.Equal(t, map[string]string{
+		"config/node_key.json": "config/node_key.json",
+		"data/blockstore.db":   "data/blockstore.db",
+	}, files)
+}
@@ -0,0 +1,56 @@
+package networkchain
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	sperrors "github.com/tendermint/starport/starport/errors"
+	"github.com/tendermint/starport/starport/pkg/goenv"
+)
+
+// DetectBinaryPath returns the absolute path of the chain binary by searching it in $PATH
+// and in the locations where binaries are usually installed
+func (c *Chain) DetectBinaryPath() (string, error) {
+	binaryName, err := c.chain.Binary()
+	if err != nil {
+		return "", err
+	}
+
+	if path, err := exec.LookPath(binaryName); err == nil {
+		return filepath.Abs(path)
+	}
+	searched := []string{"$PATH"}
+
+	candidates := []string{filepath.Join(goenv.Bin(), binaryName)}
+	if home, err := os.UserHomeDir(); err == nil {
+		candidates = append(candidates, filepath.Join(home, "go", "bin", binaryName))
+	}
+	candidates = append(candidates, filepath.Join("/usr/local/bin", binaryName))
+
+	for _, candidate := range candidates {
+		if isSearched(searched, candidate) {
+			continue
+		}
+		searched = append(searched, candidate)
+
+		if info, err := os.Stat(candidate); err == nil && !info.IsDir() {
+			return filepath.Abs(candidate)
+		}
+	}
+
+	return "", sperrors.ErrBinaryNotFound{
+		Binary:        binaryName,
+		SearchedPaths: searched,
+	}
+}
+
+// isSearched checks if the path is part of the searched locations
+func isSearched(searched []string, path string) bool {
+	for _, s := range searched {
+		if s == path {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,55 @@
+package networkchain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/tendermint/starport/starport/pkg/tendermintrpc"
+)
+
+// ErrContextCancelled is returned when the context is cancelled while waiting for the chain
+var ErrContextCancelled = errors.New("context cancelled")
+
+// contextCancelledError wraps the error of a cancelled context and is identified as ErrContextCancelled
+type contextCancelledError struct {
+	err error
+}
+
+func (e contextCancelledError) Error() string {
+	return ErrContextCancelled.Error() + ": " + e.err.Error()
+}
+
+func (e contextCancelledError) Unwrap() error {
+	return e.err
+}
+
+func (e contextCancelledError) Is(target error) bool {
+	return target == ErrContextCancelled
+}
+
+// WaitForBlock polls the node RPC of the chain until the block at the provided height is committed
+func (c Chain) WaitForBlock(ctx context.Context, height int64) error {
+	addr, err := c.rpcAddress()
+	if err != nil {
+		return err
+	}
+	client := tendermintrpc.New(addr)
+
+	ticker := time.NewTicker(c.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		// the node might not be started yet, errors are ignored until the context is cancelled
+		latestHeight, err := client.GetLatestBlockHeight(ctx)
+		if err == nil && latestHeight >= height {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return contextCancelledError{ctx.Err()}
+		case <-ticker.C:
+		}
+	}
+}
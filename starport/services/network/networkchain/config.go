@@ -0,0 +1,403 @@
+package networkchain
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pelletier/go-toml"
+	"github.com/tendermint/starport/starport/pkg/events"
+	"github.com/tendermint/starport/starport/pkg/xurl"
+)
+
+// configTOMLSections are the sections of the Tendermint config stored in config.toml
+var configTOMLSections = map[string]bool{
+	"p2p":       true,
+	"rpc":       true,
+	"consensus": true,
+	"mempool":   true,
+	"statesync": true,
+}
+
+// appTOMLSections are the sections of the Cosmos SDK app config stored in app.toml
+var appTOMLSections = map[string]bool{
+	"api":  true,
+	"grpc": true,
+}
+
+const (
+	// defaultAPIAddress is the address of the REST API of a node when no address is provided
+	defaultAPIAddress = "tcp://0.0.0.0:1317"
+
+	// defaultGRPCAddress is the address of the gRPC server of a node when no address is provided
+	defaultGRPCAddress = "0.0.0.0:9090"
+
+	// pruningModeCustom is the pruning mode using the pruning parameters of app.toml
+	pruningModeCustom = "custom"
+)
+
+// pruningModes are the state pruning strategies supported by the Cosmos SDK
+var pruningModes = map[string]bool{
+	"default":         true,
+	"nothing":         true,
+	"everything":      true,
+	pruningModeCustom: true,
+}
+
+// maxMonikerLength is the maximum length of the moniker of a node accepted by the staking module
+const maxMonikerLength = 70
+
+var (
+	// ErrInvalidPruningMode is returned when a pruning mode is not supported by the Cosmos SDK
+	ErrInvalidPruningMode = errors.New("invalid pruning mode")
+
+	// ErrInvalidMoniker is returned when a moniker is empty, too long or contains non printable ASCII characters
+	ErrInvalidMoniker = errors.New("invalid moniker")
+
+	// ErrInvalidExternalAddress is returned when an external address is not a dialable host:port address
+	ErrInvalidExternalAddress = errors.New("invalid external address")
+
+	// ErrDuplicatePeer is returned when a peer is added to the persistent peers of a node that already contain its node ID
+	ErrDuplicatePeer = errors.New("duplicate peer")
+
+	// ErrPeerNotFound is returned when a peer is removed from the persistent peers of a node that don't contain its node ID
+	ErrPeerNotFound = errors.New("peer not found")
+)
+
+const (
+	// fileLockTimeout is the maximum duration to wait for the lock of a file
+	fileLockTimeout = 10 * time.Second
+
+	// fileLockRetryInterval is the interval between the attempts to acquire the lock of a file
+	fileLockRetryInterval = 50 * time.Millisecond
+)
+
+// SetConfigValue sets the value of a key inside a section of the chain config,
+// the TOML file to update is selected from the section
+func (c Chain) SetConfigValue(section, key string, value interface{}) error {
+	var (
+		path string
+		err  error
+	)
+	switch {
+	case configTOMLSections[section]:
+		path, err = c.chain.ConfigTOMLPath()
+	case appTOMLSections[section]:
+		path, err = c.chain.AppTOMLPath()
+	default:
+		return fmt.Errorf("unknown config section %q", section)
+	}
+	if err != nil {
+		return err
+	}
+
+	return updateTOML(path, func(config *toml.Tree) error {
+		config.Set(section+"."+key, value)
+		return nil
+	})
+}
+
+// EnableAPI enables the REST API of the node of the chain listening on addr, or on the default API address if addr is empty
+func (c Chain) EnableAPI(addr string) error {
+	if addr == "" {
+		addr = defaultAPIAddress
+	}
+	return c.setAppTOMLValues(map[string]interface{}{
+		"api.enable":  true,
+		"api.address": addr,
+	})
+}
+
+// EnableGRPC enables the gRPC server of the node of the chain listening on addr, or on the default gRPC address if addr is empty
+func (c Chain) EnableGRPC(addr string) error {
+	if addr == "" {
+		addr = defaultGRPCAddress
+	}
+	return c.setAppTOMLValues(map[string]interface{}{
+		"grpc.enable":  true,
+		"grpc.address": addr,
+	})
+}
+
+// SetStateSync enables the state sync of the node of the chain from the RPC servers, trusting the block
+// with trustHash at trustHeight, Tendermint requires at least two RPC servers to verify the light blocks
+func (c Chain) SetStateSync(rpcServers []string, trustHeight int64, trustHash string) error {
+	if len(rpcServers) < 2 {
+		return fmt.Errorf("state sync requires at least 2 RPC servers, got %d", len(rpcServers))
+	}
+	if trustHeight <= 0 {
+		return fmt.Errorf("state sync trust height must be positive, got %d", trustHeight)
+	}
+	if _, err := hex.DecodeString(trustHash); err != nil || len(trustHash) != 64 {
+		return fmt.Errorf("state sync trust hash %q must be a 64 characters hex string", trustHash)
+	}
+
+	return c.setConfigTOMLValues(map[string]interface{}{
+		"statesync.enable":       true,
+		"statesync.rpc_servers":  strings.Join(rpcServers, ","),
+		"statesync.trust_height": trustHeight,
+		"statesync.trust_hash":   trustHash,
+	})
+}
+
+// AddPersistentPeer adds the peer <nodeID>@<addr> to the persistent peers of the node of the chain in config.toml,
+// the existing persistent peers are kept and ErrDuplicatePeer is returned if they already contain the node ID
+func (c Chain) AddPersistentPeer(nodeID, addr string) error {
+	if nodeID == "" || addr == "" {
+		return fmt.Errorf("invalid peer %s@%s", nodeID, addr)
+	}
+
+	return c.updatePersistentPeers(func(peers []string) ([]string, error) {
+		for _, peer := range peers {
+			if peerNodeID(peer) == nodeID {
+				return nil, fmt.Errorf("%w: node %s is already a persistent peer", ErrDuplicatePeer, nodeID)
+			}
+		}
+		return append(peers, fmt.Sprintf("%s@%s", nodeID, addr)), nil
+	})
+}
+
+// RemovePersistentPeer removes the peer with the node ID from the persistent peers of the node of the chain
+// in config.toml, ErrPeerNotFound is returned if the persistent peers don't contain the node ID
+func (c Chain) RemovePersistentPeer(nodeID string) error {
+	return c.updatePersistentPeers(func(peers []string) ([]string, error) {
+		for i, peer := range peers {
+			if peerNodeID(peer) == nodeID {
+				return append(peers[:i], peers[i+1:]...), nil
+			}
+		}
+		return nil, fmt.Errorf("%w: node %s is not a persistent peer", ErrPeerNotFound, nodeID)
+	})
+}
+
+// updatePersistentPeers replaces the persistent peers of config.toml with the peers returned by update
+func (c Chain) updatePersistentPeers(update func(peers []string) ([]string, error)) error {
+	path, err := c.chain.ConfigTOMLPath()
+	if err != nil {
+		return err
+	}
+
+	return updateTOML(path, func(config *toml.Tree) error {
+		var peers []string
+		if current, ok := config.Get("p2p.persistent_peers").(string); ok && current != "" {
+			for _, peer := range strings.Split(current, ",") {
+				peers = append(peers, strings.TrimSpace(peer))
+			}
+		}
+		peers, err := update(peers)
+		if err != nil {
+			return err
+		}
+		config.Set("p2p.persistent_peers", strings.Join(peers, ","))
+		return nil
+	})
+}
+
+// peerNodeID returns the node ID of a peer address <nodeID>@<host>:<port>
+func peerNodeID(peer string) string {
+	return strings.Split(peer, "@")[0]
+}
+
+// SetExternalAddress sets the P2P address advertised by the node of the chain to its peers in config.toml,
+// addr is a host:port address where the host is either an IP or a hostname that can be resolved
+func (c Chain) SetExternalAddress(addr string) error {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return fmt.Errorf("%w %q: %s", ErrInvalidExternalAddress, addr, err)
+	}
+	if p, err := strconv.ParseUint(port, 10, 16); err != nil || p == 0 {
+		return fmt.Errorf("%w %q: invalid port %q", ErrInvalidExternalAddress, addr, port)
+	}
+	if host == "" {
+		return fmt.Errorf("%w %q: missing host", ErrInvalidExternalAddress, addr)
+	}
+
+	ips := []net.IP{net.ParseIP(host)}
+	if ips[0] == nil {
+		resolved, err := net.DefaultResolver.LookupIPAddr(context.Background(), host)
+		if err != nil {
+			return fmt.Errorf("%w %q: cannot resolve host: %s", ErrInvalidExternalAddress, addr, err)
+		}
+		ips = ips[:0]
+		for _, ip := range resolved {
+			ips = append(ips, ip.IP)
+		}
+	}
+	for _, ip := range ips {
+		if ip.IsUnspecified() || ip.IsMulticast() {
+			return fmt.Errorf("%w %q: %s is not dialable", ErrInvalidExternalAddress, addr, ip)
+		}
+	}
+
+	return c.SetConfigValue("p2p", "external_address", addr)
+}
+
+// SetMoniker sets the moniker of the node of the chain in config.toml
+func (c Chain) SetMoniker(moniker string) error {
+	if len(moniker) == 0 || len(moniker) > maxMonikerLength {
+		return fmt.Errorf("%w: must be between 1 and %d characters, got %d", ErrInvalidMoniker, maxMonikerLength, len(moniker))
+	}
+	for _, r := range moniker {
+		if r < ' ' || r > '~' {
+			return fmt.Errorf("%w: %q contains non printable ASCII characters", ErrInvalidMoniker, moniker)
+		}
+	}
+
+	if err := c.setConfigTOMLValues(map[string]interface{}{
+		"moniker": moniker,
+	}); err != nil {
+		return err
+	}
+
+	c.ev.Send(events.New(events.StatusDone, fmt.Sprintf("Moniker set to %s", moniker)))
+
+	return nil
+}
+
+// SetMinGasPrice sets the minimum gas prices accepted by the node of the chain in app.toml
+func (c Chain) SetMinGasPrice(denom string, amount sdk.Dec) error {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return fmt.Errorf("invalid minimum gas price denom: %w", err)
+	}
+	if amount.IsNil() || amount.IsNegative() {
+		return fmt.Errorf("minimum gas price amount must be non-negative, got %s", amount)
+	}
+
+	return c.setAppTOMLValues(map[string]interface{}{
+		"minimum-gas-prices": sdk.NewDecCoinFromDec(denom, amount).String(),
+	})
+}
+
+// SetPruning sets the state pruning strategy of the node of the chain in app.toml,
+// the pruning parameters are only set for the custom pruning mode
+func (c Chain) SetPruning(mode string, keepRecent, keepEvery, interval uint64) error {
+	if !pruningModes[mode] {
+		return fmt.Errorf("%w %q, must be one of default, nothing, everything or custom", ErrInvalidPruningMode, mode)
+	}
+
+	values := map[string]interface{}{
+		"pruning": mode,
+	}
+	if mode == pruningModeCustom {
+		// the pruning parameters are strings in the app.toml generated by the chain
+		values["pruning-keep-recent"] = strconv.FormatUint(keepRecent, 10)
+		values["pruning-keep-every"] = strconv.FormatUint(keepEvery, 10)
+		values["pruning-interval"] = strconv.FormatUint(interval, 10)
+	}
+
+	return c.setAppTOMLValues(values)
+}
+
+// setAppTOMLValues sets the values of keys of the chain app.toml, nested keys are separated by dots
+func (c Chain) setAppTOMLValues(values map[string]interface{}) error {
+	path, err := c.chain.AppTOMLPath()
+	if err != nil {
+		return err
+	}
+	return setTOMLValues(path, values)
+}
+
+// setConfigTOMLValues sets the values of keys of the chain config.toml, nested keys are separated by dots
+func (c Chain) setConfigTOMLValues(values map[string]interface{}) error {
+	path, err := c.chain.ConfigTOMLPath()
+	if err != nil {
+		return err
+	}
+	return setTOMLValues(path, values)
+}
+
+// setTOMLValues sets the values of keys of the TOML file at path
+func setTOMLValues(path string, values map[string]interface{}) error {
+	return updateTOML(path, func(config *toml.Tree) error {
+		for key, value := range values {
+			config.Set(key, value)
+		}
+		return nil
+	})
+}
+
+// updateTOML updates the TOML file at path with update, the file is locked during the update
+// so concurrent updates don't override each other
+func updateTOML(path string, update func(config *toml.Tree) error) error {
+	unlock, err := lockFile(path)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	config, err := toml.LoadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := update(config); err != nil {
+		return err
+	}
+
+	return writeTOMLAtomic(path, config)
+}
+
+// rpcAddress returns the HTTP address of the node RPC from the laddr of the chain config.toml
+func (c Chain) rpcAddress() (string, error) {
+	path, err := c.chain.ConfigTOMLPath()
+	if err != nil {
+		return "", err
+	}
+
+	config, err := toml.LoadFile(path)
+	if err != nil {
+		return "", err
+	}
+	laddr, ok := config.Get("rpc.laddr").(string)
+	if !ok || laddr == "" {
+		return "", fmt.Errorf("rpc.laddr is not set in %s", path)
+	}
+
+	u, err := url.Parse(laddr)
+	if err != nil {
+		return "", err
+	}
+
+	// the node listening on all interfaces is reached through localhost
+	host := u.Hostname()
+	if ip := net.ParseIP(host); ip != nil && ip.IsUnspecified() {
+		host = "localhost"
+	}
+
+	return xurl.HTTP(net.JoinHostPort(host, u.Port())), nil
+}
+
+// writeTOMLAtomic writes the TOML tree into a temporary file that replaces the file at path once written
+func writeTOMLAtomic(path string, tree *toml.Tree) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	tmpFile, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile.Name())
+
+	if _, err := tree.WriteTo(tmpFile); err != nil {
+		tmpFile.Close()
+		return err
+	}
+	if err := tmpFile.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmpFile.Name(), info.Mode()); err != nil {
+		return err
+	}
+
+	return os.Rename(tmpFile.Name(), path)
+}
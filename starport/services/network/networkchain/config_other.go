@@ -0,0 +1,37 @@
+//go:build !darwin && !dragonfly && !freebsd && !linux && !netbsd && !openbsd
+// +build !darwin,!dragonfly,!freebsd,!linux,!netbsd,!openbsd
+
+package networkchain
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+)
+
+// lockFile acquires an exclusive lock on the file at path by creating its lock file, the lock is released
+// by calling unlock that removes the lock file. The lock file of a crashed process must be removed manually
+func lockFile(path string) (unlock func(), err error) {
+	lockPath := path + ".lock"
+
+	deadline := time.Now().Add(fileLockTimeout)
+	for {
+		f, err := os.OpenFile(lockPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+		if err == nil {
+			f.Close()
+			break
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return nil, fmt.Errorf("cannot lock %s: %w", path, err)
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("cannot lock %s, %s is locked by another process or must be removed", path, lockPath)
+		}
+		time.Sleep(fileLockRetryInterval)
+	}
+
+	return func() {
+		os.Remove(lockPath)
+	}, nil
+}
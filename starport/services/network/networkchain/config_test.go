@@ -0,0 +1,537 @@
+package networkchain
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pelletier/go-toml"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/services/network/networktypes"
+)
+
+const testConfigTOML = `moniker = "foo"
+
+[p2p]
+laddr = "tcp://0.0.0.0:26656"
+persistent_peers = "a@10.0.0.1:26656, b@10.0.0.2:26656"
+seeds = ""
+
+[rpc]
+laddr = "tcp://127.0.0.1:26657"
+
+[statesync]
+enable = false
+rpc_servers = ""
+trust_height = 0
+trust_hash = ""
+`
+
+const testAppTOML = `minimum-gas-prices = ""
+pruning = "default"
+pruning-keep-recent = "0"
+pruning-keep-every = "0"
+pruning-interval = "0"
+
+[api]
+enable = false
+address = "tcp://0.0.0.0:1317"
+
+[grpc]
+enable = true
+address = "0.0.0.0:9090"
+`
+
+// newTestConfigChain returns a chain with a home containing the fixture config files,
+// the paths of config.toml and app.toml are returned.
+func newTestConfigChain(t *testing.T) (c *Chain, configPath, appPath string) {
+	c, home := newTestChain(t)
+	configPath = filepath.Join(home, "config", "config.toml")
+	appPath = filepath.Join(home, "config", "app.toml")
+	require.NoError(t, os.WriteFile(configPath, []byte(testConfigTOML), 0644))
+	require.NoError(t, os.WriteFile(appPath, []byte(testAppTOML), 0644))
+	return c, configPath, appPath
+}
+
+// loadTestTOML loads the TOML file at path.
+func loadTestTOML(t *testing.T, path string) *toml.Tree {
+	config, err := toml.LoadFile(path)
+	require.NoError(t, err)
+	return config
+}
+
+func TestSetConfigValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		section string
+		key     string
+		value   interface{}
+		app     bool
+		err     string
+	}{
+		{
+			name:    "config.toml",
+			section: "rpc",
+			key:     "laddr",
+			value:   "tcp://0.0.0.0:26657",
+		},
+		{
+			name:    "new key",
+			section: "mempool",
+			key:     "size",
+			value:   int64(10000),
+		},
+		{
+			name:    "app.toml",
+			section: "api",
+			key:     "enable",
+			value:   true,
+			app:     true,
+		},
+		{
+			name:    "unknown section",
+			section: "foo",
+			key:     "bar",
+			value:   "baz",
+			err:     `unknown config section "foo"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, configPath, appPath := newTestConfigChain(t)
+
+			err := c.SetConfigValue(tt.section, tt.key, tt.value)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+
+			path, otherPath := configPath, appPath
+			if tt.app {
+				path, otherPath = appPath, configPath
+			}
+			key := tt.section + "." + tt.key
+			require.Equal(t, tt.value, loadTestTOML(t, path).Get(key))
+			require.NotEqual(t, tt.value, loadTestTOML(t, otherPath).Get(key))
+		})
+	}
+}
+
+func TestSetMinGasPrice(t *testing.T) {
+	tests := []struct {
+		name   string
+		denom  string
+		amount sdk.Dec
+		want   string
+		err    string
+	}{
+		{
+			name:   "min gas price",
+			denom:  "stake",
+			amount: sdk.MustNewDecFromStr("0.025"),
+			want:   "0.025000000000000000stake",
+		},
+		{
+			name:   "zero amount",
+			denom:  "stake",
+			amount: sdk.ZeroDec(),
+			want:   "0.000000000000000000stake",
+		},
+		{
+			name:   "invalid denom",
+			denom:  "1",
+			amount: sdk.MustNewDecFromStr("0.025"),
+			err:    "invalid minimum gas price denom: invalid denom: 1",
+		},
+		{
+			name:   "negative amount",
+			denom:  "stake",
+			amount: sdk.MustNewDecFromStr("-1"),
+			err:    "minimum gas price amount must be non-negative, got -1.000000000000000000",
+		},
+		{
+			name:   "nil amount",
+			denom:  "stake",
+			amount: sdk.Dec{},
+			err:    "minimum gas price amount must be non-negative, got <nil>",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _, path := newTestConfigChain(t)
+
+			err := c.SetMinGasPrice(tt.denom, tt.amount)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				require.Equal(t, "", loadTestTOML(t, path).Get("minimum-gas-prices"))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, loadTestTOML(t, path).Get("minimum-gas-prices"))
+		})
+	}
+}
+
+func TestSetPruning(t *testing.T) {
+	tests := []struct {
+		name string
+		mode string
+		want map[string]interface{}
+		err  error
+	}{
+		{
+			name: "nothing",
+			mode: "nothing",
+			want: map[string]interface{}{
+				"pruning":             "nothing",
+				"pruning-keep-recent": "0",
+				"pruning-keep-every":  "0",
+				"pruning-interval":    "0",
+			},
+		},
+		{
+			name: "custom",
+			mode: pruningModeCustom,
+			want: map[string]interface{}{
+				"pruning":             pruningModeCustom,
+				"pruning-keep-recent": "100",
+				"pruning-keep-every":  "1000",
+				"pruning-interval":    "10",
+			},
+		},
+		{
+			name: "invalid mode",
+			mode: "foo",
+			err:  ErrInvalidPruningMode,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _, path := newTestConfigChain(t)
+
+			err := c.SetPruning(tt.mode, 100, 1000, 10)
+			if tt.err != nil {
+				require.True(t, errors.Is(err, tt.err), "got %v", err)
+				require.Equal(t, "default", loadTestTOML(t, path).Get("pruning"))
+				return
+			}
+			require.NoError(t, err)
+
+			config := loadTestTOML(t, path)
+			for key, value := range tt.want {
+				require.Equal(t, value, config.Get(key), key)
+			}
+		})
+	}
+}
+
+func TestSetMoniker(t *testing.T) {
+	tests := []struct {
+		name    string
+		moniker string
+		err     error
+	}{
+		{
+			name:    "moniker",
+			moniker: "bar node",
+		},
+		{
+			name:    "max length",
+			moniker: strings.Repeat("a", maxMonikerLength),
+		},
+		{
+			name:    "empty",
+			moniker: "",
+			err:     ErrInvalidMoniker,
+		},
+		{
+			name:    "too long",
+			moniker: strings.Repeat("a", maxMonikerLength+1),
+			err:     ErrInvalidMoniker,
+		},
+		{
+			name:    "non printable characters",
+			moniker: "bar\n",
+			err:     ErrInvalidMoniker,
+		},
+		{
+			name:    "non ASCII characters",
+			moniker: "bär",
+			err:     ErrInvalidMoniker,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, path, _ := newTestConfigChain(t)
+
+			err := c.SetMoniker(tt.moniker)
+			if tt.err != nil {
+				require.True(t, errors.Is(err, tt.err), "got %v", err)
+				require.Equal(t, "foo", loadTestTOML(t, path).Get("moniker"))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.moniker, loadTestTOML(t, path).Get("moniker"))
+		})
+	}
+}
+
+func TestSetExternalAddress(t *testing.T) {
+	tests := []struct {
+		name string
+		addr string
+		err  error
+	}{
+		{
+			name: "ipv4",
+			addr: "10.0.0.1:26656",
+		},
+		{
+			name: "ipv6",
+			addr: "[2001:db8::1]:26656",
+		},
+		{
+			name: "missing port",
+			addr: "10.0.0.1",
+			err:  ErrInvalidExternalAddress,
+		},
+		{
+			name: "invalid port",
+			addr: "10.0.0.1:0",
+			err:  ErrInvalidExternalAddress,
+		},
+		{
+			name: "missing host",
+			addr: ":26656",
+			err:  ErrInvalidExternalAddress,
+		},
+		{
+			name: "unspecified ip",
+			addr: "0.0.0.0:26656",
+			err:  ErrInvalidExternalAddress,
+		},
+		{
+			name: "multicast ip",
+			addr: "224.0.0.1:26656",
+			err:  ErrInvalidExternalAddress,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, path, _ := newTestConfigChain(t)
+
+			err := c.SetExternalAddress(tt.addr)
+			if tt.err != nil {
+				require.True(t, errors.Is(err, tt.err), "got %v", err)
+				require.Nil(t, loadTestTOML(t, path).Get("p2p.external_address"))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.addr, loadTestTOML(t, path).Get("p2p.external_address"))
+		})
+	}
+}
+
+func TestEnableAPIAndGRPC(t *testing.T) {
+	tests := []struct {
+		name   string
+		enable func(c *Chain) error
+		want   map[string]interface{}
+	}{
+		{
+			name:   "api with default address",
+			enable: func(c *Chain) error { return c.EnableAPI("") },
+			want:   map[string]interface{}{"api.enable": true, "api.address": defaultAPIAddress},
+		},
+		{
+			name:   "api",
+			enable: func(c *Chain) error { return c.EnableAPI("tcp://localhost:1318") },
+			want:   map[string]interface{}{"api.enable": true, "api.address": "tcp://localhost:1318"},
+		},
+		{
+			name:   "grpc with default address",
+			enable: func(c *Chain) error { return c.EnableGRPC("") },
+			want:   map[string]interface{}{"grpc.enable": true, "grpc.address": defaultGRPCAddress},
+		},
+		{
+			name:   "grpc",
+			enable: func(c *Chain) error { return c.EnableGRPC("localhost:9091") },
+			want:   map[string]interface{}{"grpc.enable": true, "grpc.address": "localhost:9091"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, _, path := newTestConfigChain(t)
+
+			require.NoError(t, tt.enable(c))
+
+			config := loadTestTOML(t, path)
+			for key, value := range tt.want {
+				require.Equal(t, value, config.Get(key), key)
+			}
+		})
+	}
+}
+
+func TestSetStateSync(t *testing.T) {
+	const trustHash = "8D9A3E0C2F8B6D4A1E7C5B3A9F0D2E4C6B8A1D3F5E7C9B0A2D4F6E8C1B3A5D70"
+
+	tests := []struct {
+		name        string
+		rpcServers  []string
+		trustHeight int64
+		trustHash   string
+		err         string
+	}{
+		{
+			name:        "state sync",
+			rpcServers:  []string{"http://10.0.0.1:26657", "http://10.0.0.2:26657"},
+			trustHeight: 1000,
+			trustHash:   trustHash,
+		},
+		{
+			name:        "single rpc server",
+			rpcServers:  []string{"http://10.0.0.1:26657"},
+			trustHeight: 1000,
+			trustHash:   trustHash,
+			err:         "state sync requires at least 2 RPC servers, got 1",
+		},
+		{
+			name:        "invalid trust height",
+			rpcServers:  []string{"http://10.0.0.1:26657", "http://10.0.0.2:26657"},
+			trustHeight: 0,
+			trustHash:   trustHash,
+			err:         "state sync trust height must be positive, got 0",
+		},
+		{
+			name:        "invalid trust hash",
+			rpcServers:  []string{"http://10.0.0.1:26657", "http://10.0.0.2:26657"},
+			trustHeight: 1000,
+			trustHash:   "foo",
+			err:         `state sync trust hash "foo" must be a 64 characters hex string`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, path, _ := newTestConfigChain(t)
+
+			err := c.SetStateSync(tt.rpcServers, tt.trustHeight, tt.trustHash)
+			config := loadTestTOML(t, path)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				require.Equal(t, false, config.Get("statesync.enable"))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, true, config.Get("statesync.enable"))
+			require.Equal(t, "http://10.0.0.1:26657,http://10.0.0.2:26657", config.Get("statesync.rpc_servers"))
+			require.Equal(t, tt.trustHeight, config.Get("statesync.trust_height"))
+			require.Equal(t, tt.trustHash, config.Get("statesync.trust_hash"))
+		})
+	}
+}
+
+func TestPersistentPeers(t *testing.T) {
+	tests := []struct {
+		name   string
+		update func(c *Chain) error
+		want   string
+		err    error
+	}{
+		{
+			name:   "add peer",
+			update: func(c *Chain) error { return c.AddPersistentPeer("c", "10.0.0.3:26656") },
+			want:   "a@10.0.0.1:26656,b@10.0.0.2:26656,c@10.0.0.3:26656",
+		},
+		{
+			name:   "add duplicate peer",
+			update: func(c *Chain) error { return c.AddPersistentPeer("b", "10.0.0.3:26656") },
+			err:    ErrDuplicatePeer,
+		},
+		{
+			name:   "remove peer",
+			update: func(c *Chain) error { return c.RemovePersistentPeer("a") },
+			want:   "b@10.0.0.2:26656",
+		},
+		{
+			name:   "remove unknown peer",
+			update: func(c *Chain) error { return c.RemovePersistentPeer("c") },
+			err:    ErrPeerNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, path, _ := newTestConfigChain(t)
+
+			err := tt.update(c)
+			if tt.err != nil {
+				require.True(t, errors.Is(err, tt.err), "got %v", err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, loadTestTOML(t, path).Get("p2p.persistent_peers"))
+		})
+	}
+}
+
+func TestPersistentPeersConcurrentUpdates(t *testing.T) {
+	c, path, _ := newTestConfigChain(t)
+
+	const peers = 20
+	var wg sync.WaitGroup
+	for i := 0; i < peers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			require.NoError(t, c.AddPersistentPeer(fmt.Sprintf("node%d", i), "10.0.0.1:26656"))
+		}(i)
+	}
+	wg.Wait()
+
+	config := loadTestTOML(t, path)
+	for i := 0; i < peers; i++ {
+		require.Contains(t, config.Get("p2p.persistent_peers"), fmt.Sprintf("node%d@", i))
+	}
+}
+
+func TestUpdateConfigFromGenesisValidators(t *testing.T) {
+	c, path, _ := newTestConfigChain(t)
+
+	require.NoError(t, c.updateConfigFromGenesisValidators([]networktypes.GenesisValidator{
+		{Peer: "a@10.0.0.1:26656"},
+		{Peer: "b@10.0.0.2:26656", IsSeed: true},
+		{Peer: "c@10.0.0.3:26656"},
+	}))
+
+	config := loadTestTOML(t, path)
+	require.Equal(t, "a@10.0.0.1:26656,c@10.0.0.3:26656", config.Get("p2p.persistent_peers"))
+	require.Equal(t, "b@10.0.0.2:26656", config.Get("p2p.seeds"))
+}
+
+func TestLockFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.toml")
+
+	unlock, err := lockFile(path)
+	require.NoError(t, err)
+
+	// the lock is acquired once released by its owner.
+	unlocked := make(chan struct{})
+	go func() {
+		unlock, err := lockFile(path)
+		require.NoError(t, err)
+		unlock()
+		close(unlocked)
+	}()
+	unlock()
+	<-unlocked
+
+	unlock, err = lockFile(path)
+	require.NoError(t, err)
+	unlock()
+}
@@ -0,0 +1,26 @@
+//go:build darwin || dragonfly || freebsd || linux || netbsd || openbsd
+// +build darwin dragonfly freebsd linux netbsd openbsd
+
+package networkchain
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLockFileKeepsLockFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.toml")
+
+	unlock, err := lockFile(path)
+	require.NoError(t, err)
+	unlock()
+
+	// the lock is released once unlocked even if the lock file remains.
+	require.FileExists(t, path+".lock")
+
+	unlock, err = lockFile(path)
+	require.NoError(t, err)
+	unlock()
+}
@@ -0,0 +1,58 @@
+package networkchain
+
+import (
+	"context"
+	"time"
+
+	"github.com/tendermint/starport/starport/pkg/tendermintrpc"
+	"github.com/tendermint/starport/starport/services/network/networktypes"
+)
+
+// MonitorConsensus polls the consensus state of the running node of the chain and streams
+// its changes over the returned channel, the channel is closed when ctx is cancelled
+func (c Chain) MonitorConsensus(ctx context.Context) (<-chan networktypes.ConsensusState, error) {
+	addr, err := c.rpcAddress()
+	if err != nil {
+		return nil, err
+	}
+	client := tendermintrpc.New(addr)
+
+	states := make(chan networktypes.ConsensusState)
+
+	go func() {
+		defer close(states)
+
+		ticker := time.NewTicker(c.pollInterval)
+		defer ticker.Stop()
+
+		var last networktypes.ConsensusState
+		for {
+			// the node can be temporarily unreachable, the state is fetched again on next tick
+			state, err := client.GetConsensusState(ctx)
+			if err == nil {
+				current := networktypes.ConsensusState{
+					Height:   state.Height,
+					Round:    state.Round,
+					Step:     state.Step,
+					Proposer: state.Proposer,
+				}
+				if current != last {
+					select {
+					case states <- current:
+						last = current
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return states, nil
+}
@@ -0,0 +1,152 @@
+package networkchain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+
+	"github.com/tendermint/starport/starport/pkg/chaincmd"
+	"github.com/tendermint/starport/starport/pkg/cosmosutil"
+	"github.com/tendermint/starport/starport/pkg/cosmosver"
+	"github.com/tendermint/starport/starport/pkg/events"
+)
+
+// ErrNodeNotRunning is returned when an operation requires the node of the chain to be running
+var ErrNodeNotRunning = errors.New("the node of the chain is not running")
+
+var (
+	// genesisModuleFieldRe matches the module of an invalid genesis state in validate-genesis errors
+	genesisModuleFieldRe = regexp.MustCompile(`failed to validate (\S+) genesis state`)
+
+	// genesisJSONFieldRe matches the field of a genesis that cannot be decoded in validate-genesis errors
+	genesisJSONFieldRe = regexp.MustCompile(`(?:Go struct field|unknown field) "?([\w.]+)"?`)
+)
+
+// GenesisValidationError is returned when the genesis of the chain is considered as invalid by the chain binary
+type GenesisValidationError struct {
+	// Field is the genesis field the error is related to, it is empty when it can't be determined
+	Field string
+
+	Err error
+}
+
+// Error implements error
+func (e GenesisValidationError) Error() string {
+	if e.Field == "" {
+		return fmt.Sprintf("invalid genesis: %s", e.Err)
+	}
+	return fmt.Sprintf("invalid genesis field %s: %s", e.Field, e.Err)
+}
+
+// Unwrap returns the underlying error of the chain binary
+func (e GenesisValidationError) Unwrap() error {
+	return e.Err
+}
+
+// newGenesisValidationError creates a GenesisValidationError by extracting the invalid field from the error message
+func newGenesisValidationError(err error) GenesisValidationError {
+	validationErr := GenesisValidationError{Err: err}
+	for _, re := range []*regexp.Regexp{genesisModuleFieldRe, genesisJSONFieldRe} {
+		if match := re.FindStringSubmatch(err.Error()); match != nil {
+			validationErr.Field = match[1]
+			break
+		}
+	}
+	return validationErr
+}
+
+// VerifyGenesis checks the current genesis of the chain is valid with the validate-genesis command of the chain binary
+func (c *Chain) VerifyGenesis(ctx context.Context) error {
+	genesisPath, err := c.GenesisPath()
+	if err != nil {
+		return err
+	}
+	if _, err := os.Stat(genesisPath); err != nil {
+		return err
+	}
+
+	chainCmd, err := c.chain.Commands(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := chainCmd.ValidateGenesis(ctx); err != nil {
+		return newGenesisValidationError(err)
+	}
+	return nil
+}
+
+// ExportGenesis exports the current state of the chain as a genesis at the provided height,
+// the state is exported at the latest height when height is 0
+func (c *Chain) ExportGenesis(ctx context.Context, height int64) ([]byte, error) {
+	chainCmd, err := c.chain.Commands(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// the node must be running to determine the state to export
+	if _, err := chainCmd.Status(ctx); err != nil {
+		return nil, ErrNodeNotRunning
+	}
+
+	c.ev.Send(events.New(events.StatusOngoing, "Exporting the genesis"))
+
+	genesis, err := chainCmd.ExportState(ctx, chaincmd.ExportWithHeight(height))
+	if err != nil {
+		return nil, err
+	}
+
+	c.ev.Send(events.New(events.StatusDone, "Genesis exported"))
+
+	return genesis, nil
+}
+
+// MigrateGenesis migrates the provided genesis to the Cosmos SDK version of the chain
+// with the migrate command of the chain binary and returns the migrated genesis
+func (c *Chain) MigrateGenesis(ctx context.Context, inputGenesis []byte) ([]byte, error) {
+	chainCmd, err := c.chain.Commands(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// the migrate command taking the genesis file is only available from Stargate
+	if version := c.chain.Version; version.LT(cosmosver.StargateFortyVersion) {
+		return nil, fmt.Errorf("genesis migration is not supported by chains using Cosmos SDK %s", version.Version)
+	}
+
+	genesisFile, err := os.CreateTemp("", "genesis-*.json")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(genesisFile.Name())
+
+	if _, err := genesisFile.Write(inputGenesis); err != nil {
+		genesisFile.Close()
+		return nil, err
+	}
+	if err := genesisFile.Close(); err != nil {
+		return nil, err
+	}
+
+	c.ev.Send(events.New(events.StatusOngoing, "Migrating the genesis"))
+
+	genesis, err := chainCmd.Migrate(ctx, genesisFile.Name())
+	if err != nil {
+		return nil, err
+	}
+
+	c.ev.Send(events.New(events.StatusDone, "Genesis migrated"))
+
+	return genesis, nil
+}
+
+// ResetGenesisTime resets the genesis time of the chain to the zero value of its genesis
+func (c Chain) ResetGenesisTime() error {
+	genesisPath, err := c.GenesisPath()
+	if err != nil {
+		return err
+	}
+	return cosmosutil.SetGenesisTime(genesisPath, 0)
+}
@@ -0,0 +1,83 @@
+package networkchain
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	"github.com/tendermint/starport/starport/pkg/chaincmd"
+	"github.com/tendermint/starport/starport/pkg/events"
+)
+
+// CreateGentx creates a gentx for the validator account of the chain keyring with the provided self delegation
+// and commission rates using the gentx command of the chain binary, and returns the content of the gentx
+func (c *Chain) CreateGentx(
+	ctx context.Context,
+	accountName,
+	selfDelegation string,
+	commissionRate,
+	maxCommissionRate,
+	maxCommissionChangeRate float64,
+) ([]byte, error) {
+	if _, err := sdk.ParseCoinNormalized(selfDelegation); err != nil {
+		return nil, errors.Wrapf(err, "invalid self delegation %s", selfDelegation)
+	}
+	for _, r := range []struct {
+		name string
+		rate float64
+	}{
+		{"commission rate", commissionRate},
+		{"max commission rate", maxCommissionRate},
+		{"max commission change rate", maxCommissionChangeRate},
+	} {
+		if r.rate < 0 || r.rate > 1 {
+			return nil, fmt.Errorf("%s must be between 0 and 1, got %v", r.name, r.rate)
+		}
+	}
+	if commissionRate > maxCommissionRate {
+		return nil, errors.New("commission rate cannot be greater than the max commission rate")
+	}
+	if maxCommissionChangeRate > maxCommissionRate {
+		return nil, errors.New("max commission change rate cannot be greater than the max commission rate")
+	}
+
+	chainCmd, err := c.chain.Commands(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c.ev.Send(events.New(events.StatusOngoing, "Creating the gentx"))
+
+	gentxPath, err := chainCmd.Gentx(
+		ctx,
+		accountName,
+		selfDelegation,
+		chaincmd.GentxWithCommissionRate(formatRate(commissionRate)),
+		chaincmd.GentxWithCommissionMaxRate(formatRate(maxCommissionRate)),
+		chaincmd.GentxWithCommissionMaxChangeRate(formatRate(maxCommissionChangeRate)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// the gentx is only returned to not be gathered with the gentxs of the chain
+	gentx, err := os.ReadFile(gentxPath)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Remove(gentxPath); err != nil {
+		return nil, err
+	}
+
+	c.ev.Send(events.New(events.StatusDone, "Gentx created"))
+
+	return gentx, nil
+}
+
+// formatRate formats a rate as a decimal accepted by the chain binary
+func formatRate(rate float64) string {
+	return strconv.FormatFloat(rate, 'f', -1, 64)
+}
@@ -2,13 +2,31 @@ package networkchain
 
 import (
 	"context"
-	"fmt"
+	"errors"
 	"os"
+	"path/filepath"
 
+	sperrors "github.com/tendermint/starport/starport/errors"
 	"github.com/tendermint/starport/starport/pkg/cosmosutil"
 	"github.com/tendermint/starport/starport/pkg/events"
+	"github.com/tendermint/tendermint/p2p"
 )
 
+const (
+	// nodeKeyFilename is the name of the file holding the key of the node in the config directory of the chain home
+	nodeKeyFilename = "node_key.json"
+
+	// privValidatorStateFilename is the name of the file holding the last signed state of the validator
+	// in the data directory of the chain home
+	privValidatorStateFilename = "priv_validator_state.json"
+
+	// privValidatorStateZero is the content of a validator state that has never signed
+	privValidatorStateZero = `{"height":"0","round":0,"step":0}`
+)
+
+// ErrNodeKeyExists is returned when a node key is generated for a chain whose home already contains one
+var ErrNodeKeyExists = errors.New("the node key already exists")
+
 // Init initializes blockchain by building the binaries and running the init command and
 // create the initial genesis of the chain, and set up a validator key
 func (c *Chain) Init(ctx context.Context) error {
@@ -17,10 +35,24 @@ func (c *Chain) Init(ctx context.Context) error {
 		return err
 	}
 
+	// reinitializing the chain would replace the keys of the existing node
+	if !c.forceReinitialize {
+		initialized, err := c.hasNodeKey()
+		if err != nil {
+			return err
+		}
+		if c.isInitialized || initialized {
+			c.ev.Send(events.New(events.StatusDone, "Blockchain already initialized"))
+			c.isInitialized = true
+			return nil
+		}
+	}
+
 	// cleanup home dir of app if exists.
 	if err := os.RemoveAll(chainHome); err != nil {
 		return err
 	}
+	c.clearAccountAddresses()
 
 	// build the chain and initialize it with a new validator key
 	c.ev.Send(events.New(events.StatusOngoing, "Building the blockchain"))
@@ -47,6 +79,78 @@ func (c *Chain) Init(ctx context.Context) error {
 	return nil
 }
 
+// hasNodeKey checks if the home of the chain contains the node key created by the initialization of the chain
+func (c Chain) hasNodeKey() (bool, error) {
+	chainHome, err := c.chain.Home()
+	if err != nil {
+		return false, err
+	}
+
+	_, err = os.Stat(filepath.Join(chainHome, "config", nodeKeyFilename))
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// GenerateNodeKey generates a new key for the node of the chain into its home and returns the node ID,
+// ErrNodeKeyExists is returned if the home already contains a node key unless ForceReinitialize is used
+func (c *Chain) GenerateNodeKey(ctx context.Context) (nodeID string, err error) {
+	chainHome, err := c.chain.Home()
+	if err != nil {
+		return "", err
+	}
+	keyPath := filepath.Join(chainHome, "config", nodeKeyFilename)
+
+	exists, err := c.hasNodeKey()
+	if err != nil {
+		return "", err
+	}
+	if exists {
+		if !c.forceReinitialize {
+			return "", ErrNodeKeyExists
+		}
+		if err := os.Remove(keyPath); err != nil {
+			return "", err
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(keyPath), 0755); err != nil {
+		return "", err
+	}
+	nodeKey, err := p2p.LoadOrGenNodeKey(keyPath)
+	if err != nil {
+		return "", err
+	}
+
+	c.ev.Send(events.New(events.StatusDone, "Node key generated"))
+
+	return string(nodeKey.ID()), nil
+}
+
+// ResetPrivateValidatorState resets the last signed state of the validator of the chain without
+// removing the data of the chain, the node must be stopped while the state is reset
+func (c *Chain) ResetPrivateValidatorState() error {
+	chainHome, err := c.chain.Home()
+	if err != nil {
+		return err
+	}
+	statePath := filepath.Join(chainHome, "data", privValidatorStateFilename)
+
+	c.ev.Send(events.New(events.StatusOngoing, "Resetting the validator state, make sure the node is stopped"))
+
+	if err := os.MkdirAll(filepath.Dir(statePath), 0700); err != nil {
+		return err
+	}
+	if err := os.WriteFile(statePath, []byte(privValidatorStateZero), 0600); err != nil {
+		return err
+	}
+
+	c.ev.Send(events.New(events.StatusDone, "Validator state reset"))
+
+	return nil
+}
+
 // initGenesis creates the initial genesis of the genesis depending on the initial genesis type (default, url, ...)
 func (c *Chain) initGenesis(ctx context.Context) error {
 	genesisPath, err := c.chain.GenesisPath()
@@ -72,7 +176,11 @@ func (c *Chain) initGenesis(ctx context.Context) error {
 		if c.genesisHash == "" {
 			c.genesisHash = hash
 		} else if hash != c.genesisHash {
-			return fmt.Errorf("genesis from URL %s is invalid. Expected hash %s, actual hash %s", c.genesisURL, c.genesisHash, hash)
+			return sperrors.ErrGenesisHashMismatch{
+				GenesisURL: c.genesisURL,
+				Expected:   c.genesisHash,
+				Actual:     hash,
+			}
 		}
 
 		// replace the default genesis with the fetched genesis
@@ -2,10 +2,16 @@ package networkchain
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"os"
+	"path/filepath"
+	"sync"
+	"time"
 
 	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
 	"github.com/go-git/go-git/v5/plumbing"
 	sperrors "github.com/tendermint/starport/starport/errors"
 	"github.com/tendermint/starport/starport/pkg/chaincmd"
@@ -23,6 +29,9 @@ const (
 
 	// SPNDenom is the denom used for the spn chain native token
 	SPNDenom = "uspn"
+
+	// defaultPollInterval is the default interval used to poll the node RPC of the chain
+	defaultPollInterval = time.Second
 )
 
 // Chain represents a network blockchain and lets you interact with its source code and binary.
@@ -40,10 +49,18 @@ type Chain struct {
 
 	keyringBackend chaincmd.KeyringBackend
 
-	isInitialized bool
+	isInitialized     bool
+	forceReinitialize bool
+
+	pollInterval time.Duration
+
+	gitCacheDir string
 
 	ref plumbing.ReferenceName
 
+	// accountAddresses caches the addresses of the accounts of the chain keyring by account name
+	accountAddresses *sync.Map
+
 	chain *chain.Chain
 	ev    events.Bus
 	ar    cosmosaccount.Registry
@@ -120,6 +137,27 @@ func WithGenesisFromURL(genesisURL string) Option {
 	}
 }
 
+// WithGenesisHash provides the expected hash of the genesis fetched from the genesis url
+func WithGenesisHash(genesisHash string) Option {
+	return func(c *Chain) {
+		c.genesisHash = genesisHash
+	}
+}
+
+// ForceReinitialize initializes the chain even if its home already contains an initialized node
+func ForceReinitialize() Option {
+	return func(c *Chain) {
+		c.forceReinitialize = true
+	}
+}
+
+// WithPollInterval provides the interval used to poll the node RPC of the chain
+func WithPollInterval(interval time.Duration) Option {
+	return func(c *Chain) {
+		c.pollInterval = interval
+	}
+}
+
 // CollectEvents collects events from the chain.
 func CollectEvents(ev events.Bus) Option {
 	return func(c *Chain) {
@@ -127,10 +165,20 @@ func CollectEvents(ev events.Bus) Option {
 	}
 }
 
+// WithLocalGitCache provides a directory of bare git repositories used to fetch
+// the source of the chain before reaching its remote
+func WithLocalGitCache(cacheDir string) Option {
+	return func(c *Chain) {
+		c.gitCacheDir = cacheDir
+	}
+}
+
 // New initializes a network blockchain from source and options.
 func New(ctx context.Context, ar cosmosaccount.Registry, source SourceOption, options ...Option) (*Chain, error) {
 	c := &Chain{
-		ar: ar,
+		ar:               ar,
+		pollInterval:     defaultPollInterval,
+		accountAddresses: &sync.Map{},
 	}
 	for _, apply := range options {
 		apply(c)
@@ -140,7 +188,7 @@ func New(ctx context.Context, ar cosmosaccount.Registry, source SourceOption, op
 	c.ev.Send(events.New(events.StatusOngoing, "Fetching the source code"))
 
 	var err error
-	if c.path, c.hash, err = fetchSource(ctx, c.url, c.ref, c.hash); err != nil {
+	if c.path, c.hash, err = fetchSource(ctx, c.url, c.ref, c.hash, c.gitCacheDir); err != nil {
 		return nil, err
 	}
 
@@ -240,22 +288,57 @@ func (c Chain) Peer(ctx context.Context, addr string) (string, error) {
 	return fmt.Sprintf("%s@%s", nodeID, addr), nil
 }
 
-// fetchSource fetches the chain source from url and returns a temporary path where source is saved
+// fetchSource fetches the chain source from url and returns a temporary path where source is saved,
+// when cacheDir is set and customHash pins the source, the source is first cloned from the local bare
+// repository of url in cacheDir and the remote is only reached when the local clone fails. unpinned
+// sources are always fetched from the remote since the ref may have moved, the cache is then updated
 func fetchSource(
 	ctx context.Context,
 	url string,
 	ref plumbing.ReferenceName,
 	customHash string,
+	cacheDir string,
 ) (path, hash string, err error) {
-	var repo *git.Repository
-
 	if path, err = os.MkdirTemp("", ""); err != nil {
 		return "", "", err
 	}
 
+	if cacheDir != "" && customHash != "" {
+		cachePath := gitCachePath(cacheDir, url)
+		if hash, err = cloneSource(ctx, path, cachePath, ref, customHash); err == nil {
+			return path, hash, nil
+		}
+
+		// the local clone is incomplete, clean it before cloning from the remote
+		if err := os.RemoveAll(path); err != nil {
+			return "", "", err
+		}
+	}
+
+	if hash, err = cloneSource(ctx, path, url, ref, customHash); err != nil {
+		return "", "", err
+	}
+
+	if cacheDir != "" {
+		// keep the source available for the next fetches, the cache is
+		// only an optimization so the source is still usable on failure
+		_ = updateGitCache(ctx, gitCachePath(cacheDir, url), path)
+	}
+
+	return path, hash, nil
+}
+
+// cloneSource clones the source from url into path and returns the hash of the checked out commit
+func cloneSource(
+	ctx context.Context,
+	path string,
+	url string,
+	ref plumbing.ReferenceName,
+	customHash string,
+) (hash string, err error) {
 	// ensure the path for chain source exists
 	if err := os.MkdirAll(path, 0755); err != nil {
-		return "", "", err
+		return "", err
 	}
 
 	// prepare clone options.
@@ -268,37 +351,71 @@ func fetchSource(
 		gitoptions.ReferenceName = ref
 		gitoptions.SingleBranch = true
 	}
-	if repo, err = git.PlainCloneContext(ctx, path, false, gitoptions); err != nil {
-		return "", "", err
+	repo, err := git.PlainCloneContext(ctx, path, false, gitoptions)
+	if err != nil {
+		return "", err
 	}
 
 	if customHash != "" {
-		hash = customHash
-
 		// checkout to a certain hash when specified. this is used by validators to make sure to use
 		// the locked version of the blockchain.
 		wt, err := repo.Worktree()
 		if err != nil {
-			return "", "", err
+			return "", err
 		}
 		h, err := repo.ResolveRevision(plumbing.Revision(customHash))
 		if err != nil {
-			return "", "", err
+			return "", err
 		}
 		githash := *h
 		if err := wt.Checkout(&git.CheckoutOptions{
 			Hash: githash,
 		}); err != nil {
-			return "", "", err
+			return "", err
 		}
-	} else {
-		// when no specific hash is provided. HEAD is fetched
-		ref, err := repo.Head()
-		if err != nil {
-			return "", "", err
-		}
-		hash = ref.Hash().String()
+		return customHash, nil
 	}
 
-	return path, hash, nil
+	// when no specific hash is provided. HEAD is fetched
+	head, err := repo.Head()
+	if err != nil {
+		return "", err
+	}
+	return head.Hash().String(), nil
+}
+
+// gitCachePath returns the path of the bare repository of url in the git cache dir
+func gitCachePath(cacheDir, url string) string {
+	sum := sha256.Sum256([]byte(url))
+	return filepath.Join(cacheDir, hex.EncodeToString(sum[:])+".git")
+}
+
+// updateGitCache fetches the branches and tags of the source cloned in sourcePath
+// into the bare repository at cachePath, the bare repository is created when missing
+func updateGitCache(ctx context.Context, cachePath, sourcePath string) error {
+	repo, err := git.PlainOpen(cachePath)
+	if err == git.ErrRepositoryNotExists {
+		repo, err = git.PlainInit(cachePath, true)
+	}
+	if err != nil {
+		return err
+	}
+
+	// the branches of the source are tracked as remote branches of its origin
+	remote := git.NewRemote(repo.Storer, &config.RemoteConfig{
+		Name: "source",
+		URLs: []string{sourcePath},
+	})
+	err = remote.FetchContext(ctx, &git.FetchOptions{
+		RefSpecs: []config.RefSpec{
+			"+refs/heads/*:refs/heads/*",
+			"+refs/remotes/origin/*:refs/heads/*",
+			"+refs/tags/*:refs/tags/*",
+		},
+		Tags: git.NoTags,
+	})
+	if err != nil && err != git.NoErrAlreadyUpToDate {
+		return err
+	}
+	return nil
 }
@@ -0,0 +1,103 @@
+package networkchain
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/services/chain"
+)
+
+// newTestChain returns a chain with a Stargate app and an empty home, the home path is returned
+func newTestChain(t *testing.T) (*Chain, string) {
+	appPath, home := t.TempDir(), t.TempDir()
+	goMod := "module github.com/test/mars\n\ngo 1.16\n\nrequire github.com/cosmos/cosmos-sdk v0.44.5\n"
+	require.NoError(t, os.WriteFile(filepath.Join(appPath, "go.mod"), []byte(goMod), 0644))
+	require.NoError(t, os.MkdirAll(filepath.Join(home, "config"), 0755))
+
+	c, err := chain.New(appPath, chain.HomePath(home))
+	require.NoError(t, err)
+
+	return &Chain{home: home, chain: c, accountAddresses: &sync.Map{}}, home
+}
+
+// commitFile commits a file with content to the repository at path and returns the hash of the commit
+func commitFile(t *testing.T, repo *git.Repository, path, name, content string) string {
+	require.NoError(t, os.WriteFile(filepath.Join(path, name), []byte(content), 0644))
+	wt, err := repo.Worktree()
+	require.NoError(t, err)
+	_, err = wt.Add(name)
+	require.NoError(t, err)
+	commit, err := wt.Commit("update "+name, &git.CommitOptions{
+		Author: &object.Signature{Name: "foo", Email: "foo@bar.com", When: time.Now()},
+	})
+	require.NoError(t, err)
+	return commit.String()
+}
+
+func TestFetchSourceLocalGitCache(t *testing.T) {
+	ctx := context.Background()
+
+	// create the remote repository of the chain source
+	remotePath := t.TempDir()
+	repo, err := git.PlainInit(remotePath, false)
+	require.NoError(t, err)
+	commit := commitFile(t, repo, remotePath, "go.mod", "module foo")
+
+	// the first fetch clones from the remote and fills the cache
+	cacheDir := t.TempDir()
+	path, hash, err := fetchSource(ctx, remotePath, "", "", cacheDir)
+	require.NoError(t, err)
+	defer os.RemoveAll(path)
+	require.Equal(t, commit, hash)
+	require.DirExists(t, gitCachePath(cacheDir, remotePath))
+
+	// the unpinned fetches get the latest commit of the remote and update the cache
+	newCommit := commitFile(t, repo, remotePath, "main.go", "package main")
+	path, hash, err = fetchSource(ctx, remotePath, "", "", cacheDir)
+	require.NoError(t, err)
+	defer os.RemoveAll(path)
+	require.Equal(t, newCommit, hash)
+
+	// the pinned fetches are served by the cache once the remote is unavailable
+	require.NoError(t, os.RemoveAll(remotePath))
+	for _, pinned := range []string{commit, newCommit} {
+		path, hash, err = fetchSource(ctx, remotePath, "", pinned, cacheDir)
+		require.NoError(t, err)
+		defer os.RemoveAll(path)
+		require.Equal(t, pinned, hash)
+		require.FileExists(t, filepath.Join(path, "go.mod"))
+	}
+
+	// the remote is still required for unpinned sources and sources that are not cached
+	_, _, err = fetchSource(ctx, remotePath, "", "", cacheDir)
+	require.Error(t, err)
+	_, _, err = fetchSource(ctx, remotePath, "", commit, t.TempDir())
+	require.Error(t, err)
+}
+
+func TestAccountAddressCache(t *testing.T) {
+	// the cache is created for the chains not created with New.
+	var c Chain
+	c.accountAddressCache().Store("alice", "cosmos1alice")
+	address, ok := c.accountAddressCache().Load("alice")
+	require.True(t, ok)
+	require.Equal(t, "cosmos1alice", address)
+
+	// the cache is cleared for all the copies of the chain when the keyring changes.
+	copied := c
+	copied.clearAccountAddresses()
+	_, ok = c.accountAddressCache().Load("alice")
+	require.False(t, ok)
+
+	// clearing a missing cache is a no-op.
+	var empty Chain
+	empty.clearAccountAddresses()
+	require.Nil(t, empty.accountAddresses)
+}
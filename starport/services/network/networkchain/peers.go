@@ -0,0 +1,54 @@
+package networkchain
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"github.com/tendermint/starport/starport/pkg/tendermintrpc"
+	"github.com/tendermint/starport/starport/services/network/networktypes"
+)
+
+// GetPeers returns the peers currently connected to the running node of the chain
+func (c Chain) GetPeers(ctx context.Context) ([]networktypes.Peer, error) {
+	addr, err := c.rpcAddress()
+	if err != nil {
+		return nil, err
+	}
+
+	netInfo, err := tendermintrpc.New(addr).GetNetInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	peers := make([]networktypes.Peer, 0, len(netInfo.Peers))
+	for _, peer := range netInfo.Peers {
+		peers = append(peers, networktypes.Peer{
+			NodeID:   peer.NodeID,
+			RemoteIP: peer.RemoteIP,
+			Moniker:  peer.Moniker,
+			Address:  peerAddress(peer.RemoteIP, peer.ListenAddr),
+		})
+	}
+
+	return peers, nil
+}
+
+// peerAddress returns the address a peer can be dialed at from the IP it is connected from
+// and the P2P port it listens on, an empty address is returned if the port is unknown
+func peerAddress(remoteIP, listenAddr string) string {
+	if remoteIP == "" {
+		return ""
+	}
+
+	// the listen address is formatted as [protocol://]host:port
+	if i := strings.Index(listenAddr, "://"); i >= 0 {
+		listenAddr = listenAddr[i+len("://"):]
+	}
+	_, port, err := net.SplitHostPort(listenAddr)
+	if err != nil || port == "" {
+		return ""
+	}
+
+	return net.JoinHostPort(remoteIP, port)
+}
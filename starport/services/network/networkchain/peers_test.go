@@ -0,0 +1,122 @@
+package networkchain
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/services/network/networktypes"
+)
+
+const netInfoResponse = `{
+  "jsonrpc": "2.0",
+  "id": -1,
+  "result": {
+    "listening": true,
+    "listeners": ["Listener(@)"],
+    "n_peers": "2",
+    "peers": [
+      {
+        "node_info": {
+          "id": "e0e4ad8bd3c0cd4fe3c4d8d36a0e0a2e8b1f5a2d",
+          "listen_addr": "tcp://0.0.0.0:26656",
+          "network": "mars-1",
+          "moniker": "foo"
+        },
+        "is_outbound": true,
+        "remote_ip": "10.0.0.1"
+      },
+      {
+        "node_info": {
+          "id": "9a0f7b0e7d7e5b8c3a7a5d0e4b2c1d9f8e7a6b5c",
+          "listen_addr": "26657",
+          "network": "mars-1",
+          "moniker": "bar"
+        },
+        "is_outbound": false,
+        "remote_ip": "10.0.0.2"
+      }
+    ]
+  }
+}`
+
+func TestGetPeers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/net_info", r.URL.Path)
+		fmt.Fprint(w, netInfoResponse)
+	}))
+	defer server.Close()
+
+	c, home := newTestChain(t)
+	laddr := strings.Replace(server.URL, "http://", "tcp://", 1)
+	config := fmt.Sprintf("[rpc]\nladdr = %q\n", laddr)
+	require.NoError(t, os.WriteFile(filepath.Join(home, "config", "config.toml"), []byte(config), 0644))
+
+	peers, err := c.GetPeers(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []networktypes.Peer{
+		{
+			NodeID:   "e0e4ad8bd3c0cd4fe3c4d8d36a0e0a2e8b1f5a2d",
+			RemoteIP: "10.0.0.1",
+			Moniker:  "foo",
+			Address:  "10.0.0.1:26656",
+		},
+		{
+			NodeID:   "9a0f7b0e7d7e5b8c3a7a5d0e4b2c1d9f8e7a6b5c",
+			RemoteIP: "10.0.0.2",
+			Moniker:  "bar",
+		},
+	}, peers)
+
+	ma, err := peers[0].ToMultiaddr()
+	require.NoError(t, err)
+	require.Equal(t, "/ip4/10.0.0.1/tcp/26656/p2p/e0e4ad8bd3c0cd4fe3c4d8d36a0e0a2e8b1f5a2d", ma)
+}
+
+func TestPeerAddress(t *testing.T) {
+	tests := []struct {
+		name       string
+		remoteIP   string
+		listenAddr string
+		want       string
+	}{
+		{
+			name:       "tcp listen address",
+			remoteIP:   "10.0.0.1",
+			listenAddr: "tcp://0.0.0.0:26656",
+			want:       "10.0.0.1:26656",
+		},
+		{
+			name:       "listen address without protocol",
+			remoteIP:   "10.0.0.1",
+			listenAddr: "0.0.0.0:26656",
+			want:       "10.0.0.1:26656",
+		},
+		{
+			name:       "ipv6 remote ip",
+			remoteIP:   "::1",
+			listenAddr: "tcp://[::]:26656",
+			want:       "[::1]:26656",
+		},
+		{
+			name:       "missing port",
+			remoteIP:   "10.0.0.1",
+			listenAddr: "26656",
+		},
+		{
+			name:       "missing remote ip",
+			listenAddr: "tcp://0.0.0.0:26656",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, peerAddress(tt.remoteIP, tt.listenAddr))
+		})
+	}
+}
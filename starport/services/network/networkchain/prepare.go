@@ -8,10 +8,10 @@ import (
 	"path/filepath"
 	"strings"
 
-	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
 	"github.com/tendermint/starport/starport/pkg/cosmosutil"
 	"github.com/tendermint/starport/starport/pkg/events"
+	"github.com/tendermint/starport/starport/pkg/xstrings"
 	"github.com/tendermint/starport/starport/services/network/networktypes"
 )
 
@@ -56,13 +56,34 @@ func (c Chain) Prepare(ctx context.Context, gi networktypes.GenesisInformation)
 	if err != nil {
 		return err
 	}
-	return cmd.UnsafeReset(ctx)
+	if err := cmd.UnsafeReset(ctx); err != nil {
+		return err
+	}
+
+	// the full path of the binary is shown when it can be found
+	binaryPath, err := c.DetectBinaryPath()
+	if err != nil {
+		if binaryPath, err = c.chain.Binary(); err != nil {
+			return err
+		}
+	}
+	c.ev.Send(events.New(events.StatusDone, fmt.Sprintf(
+		"Chain is prepared for launch, start your node with: %s start --home %s",
+		binaryPath,
+		chainHome,
+	)))
+
+	return nil
 }
 
 // buildGenesis builds the genesis for the chain from the launch approved requests
 func (c Chain) buildGenesis(ctx context.Context, gi networktypes.GenesisInformation) error {
 	c.ev.Send(events.New(events.StatusOngoing, "Building the genesis"))
 
+	if err := gi.Validate(c.launchTime); err != nil {
+		return errors.Wrap(err, "invalid genesis information")
+	}
+
 	addressPrefix, err := c.detectPrefix(ctx)
 	if err != nil {
 		return errors.Wrap(err, "error detecting chain prefix")
@@ -107,6 +128,13 @@ func (c Chain) applyGenesisAccounts(
 	}
 
 	for _, acc := range genesisAccs {
+		if err := xstrings.ValidateBech32(acc.Address); err != nil {
+			return fmt.Errorf("invalid genesis account address %s: %w", acc.Address, err)
+		}
+		if err := cosmosutil.IsValidCoinStr(acc.Coins); err != nil {
+			return err
+		}
+
 		// change the address prefix to the target chain prefix
 		acc.Address, err = cosmosutil.ChangeAddressPrefix(acc.Address, addressPrefix)
 		if err != nil {
@@ -135,6 +163,16 @@ func (c Chain) applyVestingAccounts(
 	}
 
 	for _, acc := range vestingAccs {
+		if err := xstrings.ValidateBech32(acc.Address); err != nil {
+			return fmt.Errorf("invalid vesting account address %s: %w", acc.Address, err)
+		}
+		if err := cosmosutil.IsValidCoinStr(acc.TotalBalance); err != nil {
+			return err
+		}
+		if err := cosmosutil.IsValidCoinStr(acc.Vesting); err != nil {
+			return err
+		}
+
 		acc.Address, err = cosmosutil.ChangeAddressPrefix(acc.Address, addressPrefix)
 		if err != nil {
 			return err
@@ -195,33 +233,25 @@ func (c Chain) applyGenesisValidators(ctx context.Context, genesisVals []network
 	return c.updateConfigFromGenesisValidators(genesisVals)
 }
 
-// updateConfigFromGenesisValidators adds the peer addresses into the config.toml of the chain
+// updateConfigFromGenesisValidators adds the peer and seed addresses into the config.toml of the chain
 func (c Chain) updateConfigFromGenesisValidators(genesisVals []networktypes.GenesisValidator) error {
-	var p2pAddresses []string
+	var p2pAddresses, seedAddresses []string
 	for _, val := range genesisVals {
-		p2pAddresses = append(p2pAddresses, val.Peer)
+		if val.IsSeed {
+			seedAddresses = append(seedAddresses, val.Peer)
+		} else {
+			p2pAddresses = append(p2pAddresses, val.Peer)
+		}
 	}
 
-	// set persistent peers
-	configPath, err := c.chain.ConfigTOMLPath()
-	if err != nil {
-		return err
-	}
-	configToml, err := toml.LoadFile(configPath)
-	if err != nil {
-		return err
-	}
-	configToml.Set("p2p.persistent_peers", strings.Join(p2pAddresses, ","))
-	if err != nil {
-		return err
+	values := map[string]interface{}{
+		"p2p.persistent_peers": strings.Join(p2pAddresses, ","),
 	}
 
-	// save config.toml file
-	configTomlFile, err := os.OpenFile(configPath, os.O_RDWR|os.O_TRUNC, 0644)
-	if err != nil {
-		return err
+	// set seeds, the existing seeds are kept if no validator is a seed
+	if len(seedAddresses) > 0 {
+		values["p2p.seeds"] = strings.Join(seedAddresses, ",")
 	}
-	defer configTomlFile.Close()
-	_, err = configToml.WriteTo(configTomlFile)
-	return err
+
+	return c.setConfigTOMLValues(values)
 }
@@ -0,0 +1,39 @@
+package networkchain
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/pkg/errors"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	"github.com/tendermint/tendermint/privval"
+)
+
+// privValidatorKeyFilename is the name of the file holding the validator key in the config directory of the chain home
+const privValidatorKeyFilename = "priv_validator_key.json"
+
+// GetValidatorKey returns the consensus public key of the validator from the validator key file of the chain
+func (c *Chain) GetValidatorKey(ctx context.Context) (cryptotypes.PubKey, error) {
+	chainHome, err := c.chain.Home()
+	if err != nil {
+		return nil, err
+	}
+
+	keyFile, err := os.ReadFile(filepath.Join(chainHome, "config", privValidatorKeyFilename))
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot read the validator key")
+	}
+
+	var key privval.FilePVKey
+	if err := tmjson.Unmarshal(keyFile, &key); err != nil {
+		return nil, errors.Wrap(err, "cannot decode the validator key")
+	}
+	if key.PubKey == nil {
+		return nil, errors.New("the validator key has no public key")
+	}
+
+	return cryptocodec.FromTmPubKeyInterface(key.PubKey)
+}
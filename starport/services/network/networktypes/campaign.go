@@ -0,0 +1,56 @@
+package networktypes
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	campaigntypes "github.com/tendermint/spn/x/campaign/types"
+)
+
+// Campaign represents the campaign of a chain on SPN
+type Campaign struct {
+	ID                 uint64 `json:"ID"`
+	Name               string `json:"Name"`
+	CoordinatorID      uint64 `json:"CoordinatorID"`
+	CoordinatorAddress string `json:"CoordinatorAddress"`
+	MainnetID          uint64 `json:"MainnetID"`
+	MainnetInitialized bool   `json:"MainnetInitialized"`
+	TotalSupply        string `json:"TotalSupply"`
+	AllocatedShares    string `json:"AllocatedShares"`
+	TotalShares        string `json:"TotalShares"`
+	DynamicShares      bool   `json:"DynamicShares"`
+}
+
+// ToCampaign converts a campaign data from SPN and returns a Campaign object,
+// an error is returned if the campaign data is malformed
+func ToCampaign(campaign campaigntypes.Campaign) (Campaign, error) {
+	if err := campaign.Validate(); err != nil {
+		return Campaign{}, fmt.Errorf("invalid campaign %d: %w", campaign.CampaignID, err)
+	}
+
+	return Campaign{
+		ID:                 campaign.CampaignID,
+		Name:               campaign.CampaignName,
+		CoordinatorID:      campaign.CoordinatorID,
+		MainnetID:          campaign.MainnetID,
+		MainnetInitialized: campaign.MainnetInitialized,
+		TotalSupply:        campaign.TotalSupply.String(),
+		AllocatedShares:    sdk.Coins(campaign.AllocatedShares).String(),
+		TotalShares:        sdk.Coins(campaign.TotalShares).String(),
+		DynamicShares:      campaign.DynamicShares,
+	}, nil
+}
+
+// CampaignShare represents the shares of the mainnet allocated to an account of a campaign
+type CampaignShare struct {
+	Address string `json:"Address"`
+	Shares  string `json:"Shares"`
+}
+
+// ToCampaignShare converts a mainnet account data from SPN and returns a CampaignShare object
+func ToCampaignShare(acc campaigntypes.MainnetAccount) CampaignShare {
+	return CampaignShare{
+		Address: acc.Address,
+		Shares:  sdk.Coins(acc.Shares).String(),
+	}
+}
@@ -0,0 +1,68 @@
+package networktypes_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	campaigntypes "github.com/tendermint/spn/x/campaign/types"
+	"github.com/tendermint/starport/starport/services/network/networktypes"
+)
+
+func TestToCampaign(t *testing.T) {
+	shares, err := campaigntypes.NewShares("1000s/foo")
+	require.NoError(t, err)
+
+	fetched := campaigntypes.Campaign{
+		CampaignID:         1,
+		CampaignName:       "foo",
+		CoordinatorID:      2,
+		MainnetID:          3,
+		MainnetInitialized: true,
+		TotalSupply:        sampleCoins,
+		AllocatedShares:    shares,
+		DynamicShares:      true,
+	}
+
+	campaign, err := networktypes.ToCampaign(fetched)
+	require.NoError(t, err)
+	require.EqualValues(t, networktypes.Campaign{
+		ID:                 1,
+		Name:               "foo",
+		CoordinatorID:      2,
+		MainnetID:          3,
+		MainnetInitialized: true,
+		TotalSupply:        sampleCoinsStr,
+		AllocatedShares:    sdk.Coins(shares).String(),
+		TotalShares:        "",
+		DynamicShares:      true,
+	}, campaign)
+
+	t.Run("malformed campaign", func(t *testing.T) {
+		malformed := fetched
+		malformed.CampaignName = ""
+		_, err := networktypes.ToCampaign(malformed)
+		require.Error(t, err)
+
+		malformed = fetched
+		malformed.TotalSupply = sdk.Coins{{Denom: "foo", Amount: sdk.NewInt(-1)}}
+		_, err = networktypes.ToCampaign(malformed)
+		require.Error(t, err)
+	})
+}
+
+func TestToCampaignShare(t *testing.T) {
+	shares, err := campaigntypes.NewShares("1000foo,500bar")
+	require.NoError(t, err)
+
+	fetched := campaigntypes.MainnetAccount{
+		CampaignID: 1,
+		Address:    "spn123",
+		Shares:     shares,
+	}
+
+	require.EqualValues(t, networktypes.CampaignShare{
+		Address: "spn123",
+		Shares:  "500s/bar,1000s/foo",
+	}, networktypes.ToCampaignShare(fetched))
+}
@@ -1,17 +1,23 @@
 package networktypes
 
-import launchtypes "github.com/tendermint/spn/x/launch/types"
+import (
+	"time"
+
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+)
 
 // ChainLaunch represents the launch of a chain on SPN
 type ChainLaunch struct {
-	ID          uint64 `json:"ID"`
-	ChainID     string `json:"ChainID"`
-	SourceURL   string `json:"SourceURL"`
-	SourceHash  string `json:"SourceHash"`
-	GenesisURL  string `json:"GenesisURL"`
-	GenesisHash string `json:"GenesisHash"`
-	LaunchTime  int64  `json:"LaunchTime"`
-	CampaignID  uint64 `json:"CampaignID"`
+	ID              uint64 `json:"ID"`
+	ChainID         string `json:"ChainID"`
+	CoordinatorID   uint64 `json:"CoordinatorID"`
+	SourceURL       string `json:"SourceURL"`
+	SourceHash      string `json:"SourceHash"`
+	GenesisURL      string `json:"GenesisURL"`
+	GenesisHash     string `json:"GenesisHash"`
+	LaunchTime      int64  `json:"LaunchTime"`
+	LaunchTriggered bool   `json:"LaunchTriggered"`
+	CampaignID      uint64 `json:"CampaignID"`
 }
 
 // ToChainLaunch converts a chain launch data from SPN and returns a ChainLaunch object
@@ -22,12 +28,14 @@ func ToChainLaunch(chain launchtypes.Chain) ChainLaunch {
 	}
 
 	launch := ChainLaunch{
-		ID:         chain.LaunchID,
-		ChainID:    chain.GenesisChainID,
-		SourceURL:  chain.SourceURL,
-		SourceHash: chain.SourceHash,
-		LaunchTime: launchTime,
-		CampaignID: chain.CampaignID,
+		ID:              chain.LaunchID,
+		ChainID:         chain.GenesisChainID,
+		CoordinatorID:   chain.CoordinatorID,
+		SourceURL:       chain.SourceURL,
+		SourceHash:      chain.SourceHash,
+		LaunchTime:      launchTime,
+		LaunchTriggered: chain.LaunchTriggered,
+		CampaignID:      chain.CampaignID,
 	}
 
 	// check if custom genesis URL is provided.
@@ -38,3 +46,17 @@ func ToChainLaunch(chain launchtypes.Chain) ChainLaunch {
 
 	return launch
 }
+
+// IsLive returns true if the launch of the chain is triggered and the launch time is reached
+func (c ChainLaunch) IsLive() bool {
+	return c.LaunchTriggered && c.LaunchTime <= time.Now().Unix()
+}
+
+// TimeUntilLaunch returns the duration until the launch time of the chain, the duration is negative
+// if the chain is already launched and zero if the launch is not triggered
+func (c ChainLaunch) TimeUntilLaunch() time.Duration {
+	if !c.LaunchTriggered {
+		return 0
+	}
+	return time.Until(time.Unix(c.LaunchTime, 0))
+}
@@ -2,6 +2,7 @@ package networktypes_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	launchtypes "github.com/tendermint/spn/x/launch/types"
@@ -19,6 +20,7 @@ func TestToChainLaunch(t *testing.T) {
 			fetched: launchtypes.Chain{
 				LaunchID:       1,
 				GenesisChainID: "foo-1",
+				CoordinatorID:  1,
 				SourceURL:      "foo.com",
 				SourceHash:     "0xaaa",
 				HasCampaign:    true,
@@ -26,13 +28,14 @@ func TestToChainLaunch(t *testing.T) {
 				InitialGenesis: launchtypes.NewDefaultInitialGenesis(),
 			},
 			expected: networktypes.ChainLaunch{
-				ID:          1,
-				ChainID:     "foo-1",
-				SourceURL:   "foo.com",
-				SourceHash:  "0xaaa",
-				GenesisURL:  "",
-				GenesisHash: "",
-				CampaignID:  1,
+				ID:            1,
+				ChainID:       "foo-1",
+				CoordinatorID: 1,
+				SourceURL:     "foo.com",
+				SourceHash:    "0xaaa",
+				GenesisURL:    "",
+				GenesisHash:   "",
+				CampaignID:    1,
 			},
 		},
 		{
@@ -50,14 +53,15 @@ func TestToChainLaunch(t *testing.T) {
 				),
 			},
 			expected: networktypes.ChainLaunch{
-				ID:          1,
-				ChainID:     "bar-1",
-				SourceURL:   "bar.com",
-				SourceHash:  "0xbbb",
-				GenesisURL:  "genesisfoo.com",
-				GenesisHash: "0xccc",
-				LaunchTime:  100,
-				CampaignID:  0,
+				ID:              1,
+				ChainID:         "bar-1",
+				SourceURL:       "bar.com",
+				SourceHash:      "0xbbb",
+				GenesisURL:      "genesisfoo.com",
+				GenesisHash:     "0xccc",
+				LaunchTime:      100,
+				LaunchTriggered: true,
+				CampaignID:      0,
 			},
 		},
 	}
@@ -67,3 +71,46 @@ func TestToChainLaunch(t *testing.T) {
 		})
 	}
 }
+
+func TestChainLaunchIsLive(t *testing.T) {
+	now := time.Now().Unix()
+
+	tests := []struct {
+		name     string
+		launch   networktypes.ChainLaunch
+		expected bool
+	}{
+		{
+			name:     "launch not triggered",
+			launch:   networktypes.ChainLaunch{},
+			expected: false,
+		},
+		{
+			name:     "launch time in the future",
+			launch:   networktypes.ChainLaunch{LaunchTriggered: true, LaunchTime: now + 3600},
+			expected: false,
+		},
+		{
+			name:     "launch time in the past",
+			launch:   networktypes.ChainLaunch{LaunchTriggered: true, LaunchTime: now - 3600},
+			expected: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, tt.launch.IsLive())
+		})
+	}
+}
+
+func TestChainLaunchTimeUntilLaunch(t *testing.T) {
+	now := time.Now().Unix()
+
+	require.Zero(t, networktypes.ChainLaunch{}.TimeUntilLaunch())
+
+	launch := networktypes.ChainLaunch{LaunchTriggered: true, LaunchTime: now + 3600}
+	require.True(t, launch.TimeUntilLaunch() > 0)
+
+	launch = networktypes.ChainLaunch{LaunchTriggered: true, LaunchTime: now - 3600}
+	require.True(t, launch.TimeUntilLaunch() < 0)
+}
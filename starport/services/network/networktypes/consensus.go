@@ -0,0 +1,9 @@
+package networktypes
+
+// ConsensusState represents the state of the current consensus round of a chain
+type ConsensusState struct {
+	Height   int64
+	Round    int32
+	Step     string
+	Proposer string
+}
@@ -1,10 +1,14 @@
 package networktypes
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	launchtypes "github.com/tendermint/spn/x/launch/types"
+	"github.com/tendermint/starport/starport/pkg/cosmosutil"
 )
 
 // GenesisInformation represents all information for a chain to construct the genesis.
@@ -18,6 +22,15 @@ type GenesisInformation struct {
 type GenesisAccount struct {
 	Address string
 	Coins   string
+
+	// VestingSchedule is the vesting of the coins of the account, it is nil for accounts without vesting
+	VestingSchedule *VestingSchedule
+}
+
+// VestingSchedule represents the delayed vesting of the coins of a genesis account
+type VestingSchedule struct {
+	Vesting string
+	EndTime int64
 }
 
 // VestingAccount represents a vesting account with initial coin allocation  and vesting option for the chain genesis
@@ -35,6 +48,9 @@ type GenesisValidator struct {
 	Peer           string
 	Address        string
 	SelfDelegation sdk.Coin
+
+	// IsSeed is true when the validator node is used as a seed rather than a persistent peer
+	IsSeed bool
 }
 
 // NewGenesisInformation initializes a new GenesisInformation
@@ -50,6 +66,117 @@ func NewGenesisInformation(
 	}
 }
 
+// ToGenesisInformation builds the genesis information from the requests of a launch applied in order
+// requests that can't be converted are skipped and their errors are returned alongside the genesis information
+func ToGenesisInformation(launchRequests []launchtypes.Request) (gi GenesisInformation, errs []error) {
+	for _, request := range launchRequests {
+		switch content := request.Content.Content.(type) {
+		case *launchtypes.RequestContent_GenesisAccount:
+			gi.GenesisAccounts = append(gi.GenesisAccounts, ToGenesisAccount(*content.GenesisAccount))
+		case *launchtypes.RequestContent_VestingAccount:
+			vestingAcc, err := ToVestingAccount(*content.VestingAccount)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("request %d: %w", request.RequestID, err))
+				continue
+			}
+			gi.VestingAccounts = append(gi.VestingAccounts, vestingAcc)
+		case *launchtypes.RequestContent_GenesisValidator:
+			gi.GenesisValidators = append(gi.GenesisValidators, ToGenesisValidator(*content.GenesisValidator))
+		case *launchtypes.RequestContent_AccountRemoval:
+			gi.removeAccount(content.AccountRemoval.Address)
+		case *launchtypes.RequestContent_ValidatorRemoval:
+			gi.removeValidator(content.ValidatorRemoval.ValAddress)
+		default:
+			errs = append(errs, fmt.Errorf("request %d: unknown request content", request.RequestID))
+		}
+	}
+
+	return gi, errs
+}
+
+// removeAccount removes the genesis and vesting accounts with the provided address
+func (gi *GenesisInformation) removeAccount(address string) {
+	genAccs := gi.GenesisAccounts[:0]
+	for _, acc := range gi.GenesisAccounts {
+		if acc.Address != address {
+			genAccs = append(genAccs, acc)
+		}
+	}
+	gi.GenesisAccounts = genAccs
+
+	vestingAccs := gi.VestingAccounts[:0]
+	for _, acc := range gi.VestingAccounts {
+		if acc.Address != address {
+			vestingAccs = append(vestingAccs, acc)
+		}
+	}
+	gi.VestingAccounts = vestingAccs
+}
+
+// removeValidator removes the genesis validator with the provided address
+func (gi *GenesisInformation) removeValidator(address string) {
+	genVals := gi.GenesisValidators[:0]
+	for _, val := range gi.GenesisValidators {
+		if val.Address != address {
+			genVals = append(genVals, val)
+		}
+	}
+	gi.GenesisValidators = genVals
+}
+
+// ValidationErrors aggregates all the errors found while validating genesis information
+type ValidationErrors []error
+
+// Error implements error
+func (e ValidationErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Validate checks the genesis information can be applied to the genesis of a chain launched at launchTime
+// all the invalid entries are reported in the returned ValidationErrors
+func (gi GenesisInformation) Validate(launchTime int64) error {
+	var errs ValidationErrors
+
+	for _, acc := range gi.GenesisAccounts {
+		coins, err := sdk.ParseCoinsNormalized(acc.Coins)
+		switch {
+		case err != nil:
+			errs = append(errs, fmt.Errorf("genesis account %s has invalid coins: %w", acc.Address, err))
+		case coins.Empty():
+			errs = append(errs, fmt.Errorf("genesis account %s has no coins", acc.Address))
+		}
+	}
+
+	for _, acc := range gi.VestingAccounts {
+		if acc.EndTime <= launchTime {
+			errs = append(errs, fmt.Errorf(
+				"vesting account %s ends at %d which is not after the launch time %d",
+				acc.Address,
+				acc.EndTime,
+				launchTime,
+			))
+		}
+	}
+
+	for _, val := range gi.GenesisValidators {
+		if !json.Valid(val.Gentx) {
+			errs = append(errs, fmt.Errorf("genesis validator %s has an invalid gentx", val.Address))
+		}
+		if !cosmosutil.VerifyPeerFormat(val.Peer) {
+			errs = append(errs, fmt.Errorf("genesis validator %s has an invalid peer %q", val.Address, val.Peer))
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
 // ToGenesisAccount converts genesis account from SPN
 func ToGenesisAccount(acc launchtypes.GenesisAccount) GenesisAccount {
 	return GenesisAccount{
@@ -73,6 +200,18 @@ func ToVestingAccount(acc launchtypes.VestingAccount) (VestingAccount, error) {
 	}, nil
 }
 
+// ToVestingGenesisAccount converts a vesting account into a genesis account with a vesting schedule
+func ToVestingGenesisAccount(acc VestingAccount) GenesisAccount {
+	return GenesisAccount{
+		Address: acc.Address,
+		Coins:   acc.TotalBalance,
+		VestingSchedule: &VestingSchedule{
+			Vesting: acc.Vesting,
+			EndTime: acc.EndTime,
+		},
+	}
+}
+
 // ToGenesisValidator converts genesis validator from SPN
 func ToGenesisValidator(val launchtypes.GenesisValidator) GenesisValidator {
 	return GenesisValidator{
@@ -87,6 +87,22 @@ func TestToVestingAccount(t *testing.T) {
 	}
 }
 
+func TestToVestingGenesisAccount(t *testing.T) {
+	require.Equal(t, networktypes.GenesisAccount{
+		Address: "spn123",
+		Coins:   sampleCoinsStr,
+		VestingSchedule: &networktypes.VestingSchedule{
+			Vesting: sampleCoinsStr,
+			EndTime: 1000,
+		},
+	}, networktypes.ToVestingGenesisAccount(networktypes.VestingAccount{
+		Address:      "spn123",
+		TotalBalance: sampleCoinsStr,
+		Vesting:      sampleCoinsStr,
+		EndTime:      1000,
+	}))
+}
+
 func TestToGenesisValidator(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -113,3 +129,81 @@ func TestToGenesisValidator(t *testing.T) {
 		})
 	}
 }
+
+func TestGenesisInformationValidate(t *testing.T) {
+	validGentx := []byte(`{"body":{}}`)
+
+	tests := []struct {
+		name      string
+		gi        networktypes.GenesisInformation
+		errsCount int
+	}{
+		{
+			name: "valid genesis information",
+			gi: networktypes.NewGenesisInformation(
+				[]networktypes.GenesisAccount{{Address: "spn123", Coins: sampleCoinsStr}},
+				[]networktypes.VestingAccount{{Address: "spn456", EndTime: 2000}},
+				[]networktypes.GenesisValidator{{Address: "spn789", Gentx: validGentx, Peer: "abc@0.0.0.0"}},
+			),
+		},
+		{
+			name: "all invalid entries are reported",
+			gi: networktypes.NewGenesisInformation(
+				[]networktypes.GenesisAccount{
+					{Address: "spn123", Coins: ""},
+					{Address: "spn124", Coins: "10%foo"},
+				},
+				[]networktypes.VestingAccount{{Address: "spn456", EndTime: 500}},
+				[]networktypes.GenesisValidator{{Address: "spn789", Gentx: []byte("abc"), Peer: "abc"}},
+			),
+			errsCount: 5,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.gi.Validate(1000)
+			if tt.errsCount == 0 {
+				require.NoError(t, err)
+				return
+			}
+			var errs networktypes.ValidationErrors
+			require.ErrorAs(t, err, &errs)
+			require.Len(t, errs, tt.errsCount)
+		})
+	}
+}
+
+func TestToGenesisInformation(t *testing.T) {
+	requests := []launchtypes.Request{
+		{
+			RequestID: 1,
+			Content:   launchtypes.NewGenesisAccount(0, "spn123", sampleCoins),
+		},
+		{
+			RequestID: 2,
+			Content:   launchtypes.NewGenesisAccount(0, "spn456", sampleCoins),
+		},
+		{
+			RequestID: 3,
+			Content:   launchtypes.NewVestingAccount(0, "spn789", launchtypes.VestingOptions{}),
+		},
+		{
+			RequestID: 4,
+			Content:   launchtypes.NewGenesisValidator(0, "spn123", []byte("abc"), nil, sampleCoins[0], "abc@0.0.0.0"),
+		},
+		{
+			RequestID: 5,
+			Content:   launchtypes.NewAccountRemoval("spn456"),
+		},
+		{
+			RequestID: 6,
+		},
+	}
+
+	gi, errs := networktypes.ToGenesisInformation(requests)
+	require.Len(t, errs, 2)
+	require.Equal(t, []networktypes.GenesisAccount{{Address: "spn123", Coins: sampleCoinsStr}}, gi.GenesisAccounts)
+	require.Empty(t, gi.VestingAccounts)
+	require.Len(t, gi.GenesisValidators, 1)
+	require.Equal(t, "spn123", gi.GenesisValidators[0].Address)
+}
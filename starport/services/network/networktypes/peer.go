@@ -0,0 +1,72 @@
+package networktypes
+
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
+// Peer represents a peer connected to the node of a chain
+type Peer struct {
+	NodeID   string
+	RemoteIP string
+	Moniker  string
+
+	// Address is the host:port address the peer can be dialed at
+	Address string
+}
+
+// ToMultiaddr returns the peer address in the libp2p multiaddr format
+// only TCP addresses are supported by SPN peers
+func (p Peer) ToMultiaddr() (string, error) {
+	if p.NodeID == "" {
+		return "", fmt.Errorf("peer %q has no node ID", p.Address)
+	}
+
+	host, port, err := net.SplitHostPort(p.Address)
+	if err != nil {
+		return "", fmt.Errorf("unsupported peer address %q: %w", p.Address, err)
+	}
+
+	protocol := "dns4"
+	if ip := net.ParseIP(host); ip != nil {
+		protocol = "ip4"
+		if ip.To4() == nil {
+			protocol = "ip6"
+		}
+	}
+
+	return fmt.Sprintf("/%s/%s/tcp/%s/p2p/%s", protocol, host, port, p.NodeID), nil
+}
+
+// PeerFromMultiaddr returns the peer from a TCP address in the libp2p multiaddr format
+func PeerFromMultiaddr(ma string) (Peer, error) {
+	// the address is formatted as /<protocol>/<host>/tcp/<port>/p2p/<nodeID>
+	parts := strings.Split(ma, "/")
+	if len(parts) != 7 || parts[0] != "" || parts[3] != "tcp" || parts[5] != "p2p" {
+		return Peer{}, fmt.Errorf("unsupported multiaddr %q", ma)
+	}
+
+	protocol, host, port, nodeID := parts[1], parts[2], parts[4], parts[6]
+	if host == "" || port == "" || nodeID == "" {
+		return Peer{}, fmt.Errorf("invalid multiaddr %q", ma)
+	}
+
+	peer := Peer{
+		NodeID:  nodeID,
+		Address: net.JoinHostPort(host, port),
+	}
+
+	switch protocol {
+	case "ip4", "ip6":
+		if net.ParseIP(host) == nil {
+			return Peer{}, fmt.Errorf("invalid IP %q in multiaddr %q", host, ma)
+		}
+		peer.RemoteIP = host
+	case "dns4":
+	default:
+		return Peer{}, fmt.Errorf("unsupported protocol %q in multiaddr %q", protocol, ma)
+	}
+
+	return peer, nil
+}
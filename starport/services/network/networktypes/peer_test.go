@@ -0,0 +1,62 @@
+package networktypes_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/services/network/networktypes"
+)
+
+func TestPeerMultiaddr(t *testing.T) {
+	tests := []struct {
+		name      string
+		peer      networktypes.Peer
+		multiaddr string
+	}{
+		{
+			name:      "ipv4 address",
+			peer:      networktypes.Peer{NodeID: "abc", RemoteIP: "1.2.3.4", Address: "1.2.3.4:26656"},
+			multiaddr: "/ip4/1.2.3.4/tcp/26656/p2p/abc",
+		},
+		{
+			name:      "ipv6 address",
+			peer:      networktypes.Peer{NodeID: "abc", RemoteIP: "::1", Address: "[::1]:26656"},
+			multiaddr: "/ip6/::1/tcp/26656/p2p/abc",
+		},
+		{
+			name:      "dns address",
+			peer:      networktypes.Peer{NodeID: "abc", Address: "foo.com:26656"},
+			multiaddr: "/dns4/foo.com/tcp/26656/p2p/abc",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ma, err := tt.peer.ToMultiaddr()
+			require.NoError(t, err)
+			require.Equal(t, tt.multiaddr, ma)
+
+			peer, err := networktypes.PeerFromMultiaddr(ma)
+			require.NoError(t, err)
+			require.Equal(t, tt.peer, peer)
+		})
+	}
+}
+
+func TestPeerMultiaddrInvalid(t *testing.T) {
+	_, err := networktypes.Peer{Address: "1.2.3.4:26656"}.ToMultiaddr()
+	require.Error(t, err)
+
+	_, err = networktypes.Peer{NodeID: "abc", Address: "1.2.3.4"}.ToMultiaddr()
+	require.Error(t, err)
+
+	for _, ma := range []string{
+		"",
+		"/ip4/1.2.3.4/tcp/26656",
+		"/ip4/foo/tcp/26656/p2p/abc",
+		"/udp/1.2.3.4/tcp/26656/p2p/abc",
+		"/dns4/foo.com/udp/26656/p2p/abc",
+	} {
+		_, err := networktypes.PeerFromMultiaddr(ma)
+		require.Error(t, err, ma)
+	}
+}
@@ -0,0 +1,76 @@
+package networktypes
+
+import (
+	"fmt"
+
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+)
+
+// RequestStatus is the status of a launch request
+type RequestStatus int
+
+const (
+	// RequestStatusAll matches the requests of any status
+	RequestStatusAll RequestStatus = -1
+
+	// RequestStatusPending is the status of a request waiting to be settled
+	RequestStatusPending RequestStatus = 0
+
+	// RequestStatusApproved is the status of an approved request
+	RequestStatusApproved RequestStatus = 1
+
+	// RequestStatusRejected is the status of a rejected request
+	RequestStatusRejected RequestStatus = 2
+)
+
+// LaunchRequest represents a launch request of a chain on SPN
+type LaunchRequest struct {
+	launchtypes.Request
+
+	// TypeName is the type of the request content
+	TypeName string `json:"TypeName"`
+
+	// SummaryText is a human-readable summary of the request content
+	SummaryText string `json:"SummaryText"`
+}
+
+// ToLaunchRequest converts a request data from SPN and returns a LaunchRequest object
+func ToLaunchRequest(request launchtypes.Request) LaunchRequest {
+	launchRequest := LaunchRequest{
+		Request:  request,
+		TypeName: "Unknown",
+	}
+
+	switch req := request.Content.Content.(type) {
+	case *launchtypes.RequestContent_GenesisAccount:
+		launchRequest.TypeName = "GenesisAccount"
+		launchRequest.SummaryText = fmt.Sprintf("%s, %s",
+			req.GenesisAccount.Address,
+			req.GenesisAccount.Coins.String())
+	case *launchtypes.RequestContent_GenesisValidator:
+		launchRequest.TypeName = "GenesisValidator"
+		launchRequest.SummaryText = fmt.Sprintf("%s, %s, %s",
+			req.GenesisValidator.Peer,
+			req.GenesisValidator.Address,
+			req.GenesisValidator.SelfDelegation.String())
+	case *launchtypes.RequestContent_VestingAccount:
+		launchRequest.TypeName = "VestingAccount"
+
+		// parse vesting options
+		vestingCoins := "unrecognized vesting option"
+		if dv := req.VestingAccount.VestingOptions.GetDelayedVesting(); dv != nil {
+			vestingCoins = fmt.Sprintf("%s (vesting: %s)", dv.TotalBalance, dv.Vesting)
+		}
+		launchRequest.SummaryText = fmt.Sprintf("%s, %s",
+			req.VestingAccount.Address,
+			vestingCoins)
+	case *launchtypes.RequestContent_ValidatorRemoval:
+		launchRequest.TypeName = "ValidatorRemoval"
+		launchRequest.SummaryText = req.ValidatorRemoval.ValAddress
+	case *launchtypes.RequestContent_AccountRemoval:
+		launchRequest.TypeName = "AccountRemoval"
+		launchRequest.SummaryText = req.AccountRemoval.Address
+	}
+
+	return launchRequest
+}
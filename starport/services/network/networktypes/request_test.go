@@ -0,0 +1,64 @@
+package networktypes_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+	"github.com/tendermint/starport/starport/services/network/networktypes"
+)
+
+func TestToLaunchRequest(t *testing.T) {
+	tests := []struct {
+		name            string
+		content         launchtypes.RequestContent
+		wantTypeName    string
+		wantSummaryText string
+	}{
+		{
+			name:            "genesis account",
+			content:         launchtypes.NewGenesisAccount(1, "spn123", sampleCoins),
+			wantTypeName:    "GenesisAccount",
+			wantSummaryText: "spn123, " + sampleCoinsStr,
+		},
+		{
+			name: "genesis validator",
+			content: launchtypes.NewGenesisValidator(
+				1,
+				"spn123",
+				nil,
+				nil,
+				sdk.NewCoin("stake", sdk.NewInt(1000)),
+				"node@host:26656",
+			),
+			wantTypeName:    "GenesisValidator",
+			wantSummaryText: "node@host:26656, spn123, 1000stake",
+		},
+		{
+			name:            "validator removal",
+			content:         launchtypes.NewValidatorRemoval("spn123"),
+			wantTypeName:    "ValidatorRemoval",
+			wantSummaryText: "spn123",
+		},
+		{
+			name:            "account removal",
+			content:         launchtypes.NewAccountRemoval("spn123"),
+			wantTypeName:    "AccountRemoval",
+			wantSummaryText: "spn123",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := launchtypes.Request{
+				LaunchID:  1,
+				RequestID: 2,
+				Content:   tt.content,
+			}
+			got := networktypes.ToLaunchRequest(request)
+			require.Equal(t, request, got.Request)
+			require.Equal(t, tt.wantTypeName, got.TypeName)
+			require.Equal(t, tt.wantSummaryText, got.SummaryText)
+		})
+	}
+}
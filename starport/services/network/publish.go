@@ -6,6 +6,7 @@ import (
 	campaigntypes "github.com/tendermint/spn/x/campaign/types"
 	launchtypes "github.com/tendermint/spn/x/launch/types"
 	profiletypes "github.com/tendermint/spn/x/profile/types"
+	sperrors "github.com/tendermint/starport/starport/errors"
 	"github.com/tendermint/starport/starport/pkg/cosmoserror"
 	"github.com/tendermint/starport/starport/pkg/cosmosutil"
 	"github.com/tendermint/starport/starport/pkg/events"
@@ -14,10 +15,11 @@ import (
 
 // publishOptions holds info about how to create a chain.
 type publishOptions struct {
-	genesisURL string
-	chainID    string
-	campaignID uint64
-	noCheck    bool
+	genesisURL  string
+	genesisHash string
+	chainID     string
+	campaignID  uint64
+	noCheck     bool
 }
 
 // PublishOption configures chain creation.
@@ -51,6 +53,13 @@ func WithCustomGenesis(url string) PublishOption {
 	}
 }
 
+// WithCustomGenesisHash sets the expected hash of the custom genesis.
+func WithCustomGenesisHash(hash string) PublishOption {
+	return func(o *publishOptions) {
+		o.genesisHash = hash
+	}
+}
+
 // Publish submits Genesis to SPN to announce a new network.
 func (n Network) Publish(ctx context.Context, c Chain, options ...PublishOption) (launchID, campaignID uint64, err error) {
 	o := publishOptions{}
@@ -67,6 +76,18 @@ func (n Network) Publish(ctx context.Context, c Chain, options ...PublishOption)
 		}
 	}
 
+	// ensure the custom genesis matches the expected hash.
+	if o.genesisHash != "" {
+		if genesisHash != "" && genesisHash != o.genesisHash {
+			return 0, 0, sperrors.ErrGenesisHashMismatch{
+				GenesisURL: o.genesisURL,
+				Expected:   o.genesisHash,
+				Actual:     genesisHash,
+			}
+		}
+		genesisHash = o.genesisHash
+	}
+
 	chainID := o.chainID
 	if chainID == "" {
 		chainID, err = c.ID()
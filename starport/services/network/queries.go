@@ -5,6 +5,7 @@ import (
 
 	"github.com/pkg/errors"
 	launchtypes "github.com/tendermint/spn/x/launch/types"
+	sperrors "github.com/tendermint/starport/starport/errors"
 	"github.com/tendermint/starport/starport/pkg/cosmoserror"
 	"github.com/tendermint/starport/starport/pkg/events"
 	"github.com/tendermint/starport/starport/services/network/networktypes"
@@ -17,8 +18,10 @@ func (n Network) ChainLaunch(ctx context.Context, id uint64) (networktypes.Chain
 	res, err := launchtypes.NewQueryClient(n.cosmos.Context).Chain(ctx, &launchtypes.QueryGetChainRequest{
 		LaunchID: id,
 	})
-	if err != nil {
-		return networktypes.ChainLaunch{}, cosmoserror.Unwrap(err)
+	if err = cosmoserror.Unwrap(err); err == cosmoserror.ErrInvalidRequest {
+		return networktypes.ChainLaunch{}, sperrors.ErrLaunchNotFound{LaunchID: id}
+	} else if err != nil {
+		return networktypes.ChainLaunch{}, err
 	}
 
 	n.ev.Send(events.New(events.StatusOngoing, "Chain information fetched"))
@@ -26,9 +29,32 @@ func (n Network) ChainLaunch(ctx context.Context, id uint64) (networktypes.Chain
 	return networktypes.ToChainLaunch(res.Chain), nil
 }
 
+// launchQueryOptions holds the filters applied to the queried chain launches
+type launchQueryOptions struct {
+	filterLaunchTriggered bool
+	launchTriggered       bool
+}
+
+// LaunchQueryOption configures the chain launches query
+type LaunchQueryOption func(*launchQueryOptions)
+
+// WithLaunchStatus only returns the chain launches whose launch is triggered or not
+func WithLaunchStatus(launchTriggered bool) LaunchQueryOption {
+	return func(o *launchQueryOptions) {
+		o.filterLaunchTriggered = true
+		o.launchTriggered = launchTriggered
+	}
+}
+
 // ChainLaunches fetches the chain launches from Starport Network
-func (n Network) ChainLaunches(ctx context.Context) ([]networktypes.ChainLaunch, error) {
-	var chainLaunches []networktypes.ChainLaunch
+func (n Network) ChainLaunches(ctx context.Context, options ...LaunchQueryOption) ([]networktypes.ChainLaunch, error) {
+	var (
+		chainLaunches []networktypes.ChainLaunch
+		o             launchQueryOptions
+	)
+	for _, apply := range options {
+		apply(&o)
+	}
 
 	n.ev.Send(events.New(events.StatusOngoing, "Fetching chains information"))
 	res, err := launchtypes.NewQueryClient(n.cosmos.Context).ChainAll(ctx, &launchtypes.QueryAllChainRequest{})
@@ -36,8 +62,12 @@ func (n Network) ChainLaunches(ctx context.Context) ([]networktypes.ChainLaunch,
 		return chainLaunches, cosmoserror.Unwrap(err)
 	}
 
-	// Parse fetched chains
+	// Parse fetched chains, the chain query of SPN can't filter by launch status
+	// so the chains are filtered once fetched
 	for _, chain := range res.Chain {
+		if o.filterLaunchTriggered && chain.LaunchTriggered != o.launchTriggered {
+			continue
+		}
 		chainLaunches = append(chainLaunches, networktypes.ToChainLaunch(chain))
 	}
 
@@ -46,7 +76,7 @@ func (n Network) ChainLaunches(ctx context.Context) ([]networktypes.ChainLaunch,
 
 // GenesisInformation returns all the information to construct the genesis from a chain ID
 func (n Network) GenesisInformation(ctx context.Context, launchID uint64) (gi networktypes.GenesisInformation, err error) {
-	genAccs, err := n.GenesisAccounts(ctx, launchID)
+	genAccs, err := n.plainGenesisAccounts(ctx, launchID)
 	if err != nil {
 		return gi, errors.Wrap(err, "error querying genesis accounts")
 	}
@@ -64,8 +94,27 @@ func (n Network) GenesisInformation(ctx context.Context, launchID uint64) (gi ne
 	return networktypes.NewGenesisInformation(genAccs, vestingAccs, genVals), nil
 }
 
-// GenesisAccounts returns the list of approved genesis accounts for a launch from SPN
-func (n Network) GenesisAccounts(ctx context.Context, launchID uint64) (genAccs []networktypes.GenesisAccount, err error) {
+// GenesisAccounts returns the list of approved genesis accounts for a launch from SPN including the vesting accounts,
+// the vesting schedule of the accounts without vesting is nil
+func (n Network) GenesisAccounts(ctx context.Context, launchID uint64) ([]networktypes.GenesisAccount, error) {
+	genAccs, err := n.plainGenesisAccounts(ctx, launchID)
+	if err != nil {
+		return nil, err
+	}
+
+	vestingAccs, err := n.VestingAccounts(ctx, launchID)
+	if err != nil {
+		return nil, err
+	}
+	for _, acc := range vestingAccs {
+		genAccs = append(genAccs, networktypes.ToVestingGenesisAccount(acc))
+	}
+
+	return genAccs, nil
+}
+
+// plainGenesisAccounts returns the list of approved genesis accounts without vesting for a launch from SPN
+func (n Network) plainGenesisAccounts(ctx context.Context, launchID uint64) (genAccs []networktypes.GenesisAccount, err error) {
 	n.ev.Send(events.New(events.StatusOngoing, "Fetching genesis accounts"))
 	res, err := launchtypes.NewQueryClient(n.cosmos.Context).GenesisAccountAll(ctx, &launchtypes.QueryAllGenesisAccountRequest{
 		LaunchID: launchID,
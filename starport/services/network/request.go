@@ -6,10 +6,12 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	launchtypes "github.com/tendermint/spn/x/launch/types"
+	sperrors "github.com/tendermint/starport/starport/errors"
 	"github.com/tendermint/starport/starport/pkg/cosmoserror"
 	"github.com/tendermint/starport/starport/pkg/cosmosutil"
 	"github.com/tendermint/starport/starport/pkg/events"
 	"github.com/tendermint/starport/starport/services/network/networkchain"
+	"github.com/tendermint/starport/starport/services/network/networktypes"
 )
 
 // Reviewal keeps a request's reviewal.
@@ -45,6 +47,30 @@ func (n Network) Requests(ctx context.Context, launchID uint64) ([]launchtypes.R
 	return res.Request, nil
 }
 
+// ListRequests fetches the chain requests with the given status from SPN by launch id,
+// RequestStatusAll returns the requests of any status
+func (n Network) ListRequests(
+	ctx context.Context,
+	launchID uint64,
+	status networktypes.RequestStatus,
+) ([]networktypes.LaunchRequest, error) {
+	// settled requests are removed from SPN, all the stored requests are pending
+	if status != networktypes.RequestStatusAll && status != networktypes.RequestStatusPending {
+		return nil, nil
+	}
+
+	requests, err := n.Requests(ctx, launchID)
+	if err != nil {
+		return nil, err
+	}
+
+	launchRequests := make([]networktypes.LaunchRequest, 0, len(requests))
+	for _, request := range requests {
+		launchRequests = append(launchRequests, networktypes.ToLaunchRequest(request))
+	}
+	return launchRequests, nil
+}
+
 // Request fetches the chain request from SPN by launch and request id
 func (n Network) Request(ctx context.Context, launchID, requestID uint64) (launchtypes.Request, error) {
 	res, err := launchtypes.NewQueryClient(n.cosmos.Context).Request(ctx, &launchtypes.QueryGetRequestRequest{
@@ -57,8 +83,8 @@ func (n Network) Request(ctx context.Context, launchID, requestID uint64) (launc
 	return res.Request, nil
 }
 
-// SubmitRequest submits reviewals for proposals in batch for chain.
-func (n Network) SubmitRequest(launchID uint64, reviewal ...Reviewal) error {
+// SubmitRequest submits reviewals for proposals in batch for chain and returns the hash of the transaction.
+func (n Network) SubmitRequest(launchID uint64, reviewal ...Reviewal) (txHash string, err error) {
 	n.ev.Send(events.New(events.StatusOngoing, "Submitting requests..."))
 
 	messages := make([]sdk.Msg, len(reviewal))
@@ -73,15 +99,102 @@ func (n Network) SubmitRequest(launchID uint64, reviewal ...Reviewal) error {
 
 	res, err := n.cosmos.BroadcastTx(n.account.Name, messages...)
 	if err != nil {
-		return cosmoserror.Unwrap(err)
+		return "", cosmoserror.Unwrap(err)
 	}
 
 	var requestRes launchtypes.MsgSettleRequestResponse
 	err = res.Decode(&requestRes)
 	if err != nil {
-		return cosmoserror.Unwrap(err)
+		return "", cosmoserror.Unwrap(err)
 	}
-	return nil
+	return res.TxHash, nil
+}
+
+// RevertAllPendingRequests rejects all the pending requests of a launch and returns the number of reverted requests,
+// the requests are settled in transactions containing at most the request batch size of the network.
+func (n Network) RevertAllPendingRequests(ctx context.Context, launchID uint64) (int, error) {
+	// settled requests are removed from SPN, all the requests returned for the launch are pending
+	requests, err := n.Requests(ctx, launchID)
+	if err != nil {
+		return 0, err
+	}
+
+	batchSize := n.requestBatchSize
+	if batchSize <= 0 {
+		batchSize = defaultRequestBatchSize
+	}
+
+	var reverted int
+	for start := 0; start < len(requests); start += batchSize {
+		end := start + batchSize
+		if end > len(requests) {
+			end = len(requests)
+		}
+
+		reviewals := make([]Reviewal, 0, end-start)
+		for _, request := range requests[start:end] {
+			reviewals = append(reviewals, RejectRequest(request.RequestID))
+		}
+
+		if _, err := n.SubmitRequest(launchID, reviewals...); err != nil {
+			return reverted, err
+		}
+		reverted += len(reviewals)
+	}
+
+	return reverted, nil
+}
+
+// ApproveRequest approves the pending request of a launch.
+func (n Network) ApproveRequest(ctx context.Context, launchID, requestID uint64) error {
+	return n.settleRequest(ctx, launchID, requestID, true)
+}
+
+// RejectRequest rejects the pending request of a launch.
+func (n Network) RejectRequest(ctx context.Context, launchID, requestID uint64) error {
+	return n.settleRequest(ctx, launchID, requestID, false)
+}
+
+// settleRequest checks the request is pending and settles it.
+func (n Network) settleRequest(ctx context.Context, launchID, requestID uint64, approve bool) error {
+	// settled requests are removed from SPN, a request that doesn't exist is no longer pending
+	request, err := n.Request(ctx, launchID, requestID)
+	if err == cosmoserror.ErrInvalidRequest {
+		return sperrors.ErrRequestNotPending{LaunchID: launchID, RequestID: requestID}
+	}
+	if err != nil {
+		return err
+	}
+
+	action := "Rejecting"
+	if approve {
+		action = "Approving"
+	}
+	requestType, address := requestTypeAndAddress(request)
+	n.ev.Send(events.New(events.StatusOngoing, fmt.Sprintf("%s request %d: %s %s", action, requestID, requestType, address)))
+
+	_, err = n.SubmitRequest(launchID, Reviewal{
+		RequestID:  requestID,
+		IsApproved: approve,
+	})
+	return err
+}
+
+// requestTypeAndAddress returns the type of the request and the address it concerns.
+func requestTypeAndAddress(request launchtypes.Request) (requestType, address string) {
+	switch req := request.Content.Content.(type) {
+	case *launchtypes.RequestContent_GenesisAccount:
+		return "Add Genesis Account", req.GenesisAccount.Address
+	case *launchtypes.RequestContent_GenesisValidator:
+		return "Add Genesis Validator", req.GenesisValidator.Address
+	case *launchtypes.RequestContent_VestingAccount:
+		return "Add Vesting Account", req.VestingAccount.Address
+	case *launchtypes.RequestContent_ValidatorRemoval:
+		return "Remove Validator", req.ValidatorRemoval.ValAddress
+	case *launchtypes.RequestContent_AccountRemoval:
+		return "Remove Account", req.AccountRemoval.Address
+	}
+	return "Unknown", ""
 }
 
 // verifyAddValidatorRequest verify the validator request parameters
@@ -1,14 +1,32 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
 	launchtypes "github.com/tendermint/spn/x/launch/types"
+	sperrors "github.com/tendermint/starport/starport/errors"
 )
 
+const queryRequest = "/tendermint.spn.launch.Query/Request"
+
+func TestSettleRequestNotPending(t *testing.T) {
+	// settled requests are removed from SPN
+	n := newTestNetwork(t, testNode{
+		errors: map[string]*sdkerrors.Error{queryRequest: sdkerrors.ErrInvalidRequest},
+	})
+
+	err := n.ApproveRequest(context.Background(), 1, 2)
+	require.Equal(t, sperrors.ErrRequestNotPending{LaunchID: 1, RequestID: 2}, err)
+
+	err = n.RejectRequest(context.Background(), 1, 2)
+	require.Equal(t, sperrors.ErrRequestNotPending{LaunchID: 1, RequestID: 2}, err)
+}
+
 func TestBuilderVerifyAddValidatorRequest(t *testing.T) {
 	gentx := []byte(`{
   "body": {